@@ -0,0 +1,14 @@
+# Compares the hash join with the stream join on multi-million-row CSV files.
+# Both inputs are CSV files, so they have no retractions and no time field, and the hash join is used by default.
+
+awk 'BEGIN { srand(1); print "id,customer_id,amount"; for (i = 0; i < 5000000; i++) printf "%d,%d,%.2f\n", i, int(rand() * 1000000), rand() * 1000 }' > orders.csv
+awk 'BEGIN { print "id,name,country"; for (i = 0; i < 1000000; i++) printf "%d,customer_%d,country_%d\n", i, i, i % 50 }' > customers.csv
+awk 'BEGIN { srand(2); print "id,order_id,amount"; for (i = 0; i < 2000000; i++) printf "%d,%d,%.2f\n", i, int(rand() * 5000000), rand() * 1000 }' > refunds.csv
+
+wc -l orders.csv customers.csv refunds.csv && wc -l orders.csv customers.csv refunds.csv # Get the cache warm.
+
+hyperfine --min-runs 5 -w 1 --export-markdown join_benchmarks.md \
+'OCTOSQL_NO_TELEMETRY=1 octosql "SELECT c.country, COUNT(*), SUM(o.amount) FROM orders.csv o JOIN customers.csv c ON o.customer_id = c.id GROUP BY c.country"' \
+'OCTOSQL_NO_TELEMETRY=1 octosql "SELECT c.country, COUNT(*), SUM(o.amount) FROM orders.csv o JOIN customers.csv c ON o.customer_id = c.id GROUP BY c.country" --hash-joins=false' \
+'OCTOSQL_NO_TELEMETRY=1 octosql "SELECT COUNT(*), SUM(r.amount) FROM orders.csv o JOIN refunds.csv r ON o.id = r.order_id"' \
+'OCTOSQL_NO_TELEMETRY=1 octosql "SELECT COUNT(*), SUM(r.amount) FROM orders.csv o JOIN refunds.csv r ON o.id = r.order_id" --hash-joins=false'
@@ -9,8 +9,10 @@ import (
 	"os/exec"
 	"runtime/debug"
 	"runtime/trace"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/profile"
@@ -215,6 +217,27 @@ octosql "SELECT * FROM plugins.plugins"`,
 			}
 		}
 
+		var lateRecordsOutput func(record execution.Record) error
+		if lateRecordsOutputPath != "" {
+			f, err := os.Create(lateRecordsOutputPath)
+			if err != nil {
+				return fmt.Errorf("couldn't create late records output file: %w", err)
+			}
+			defer f.Close()
+			lateRecordsOutput = formats.NewLateRecordsJSONWriter(f).Write
+		}
+		lateRecords := execution.NewLateRecords(allowedLateness, lateRecordsOutput)
+
+		memoryBudget, err := parseByteSize(memoryBudgetStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse memory budget: %w", err)
+		}
+
+		lookupJoinCacheSize, err := parseByteSize(lookupJoinCacheSizeStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse lookup join cache size: %w", err)
+		}
+
 		env := physical.Environment{
 			Aggregates: aggregates.Aggregates,
 			Functions:  functions.FunctionMap(),
@@ -222,8 +245,17 @@ octosql "SELECT * FROM plugins.plugins"`,
 				Databases:    databases,
 				FileHandlers: fileHandlers,
 			},
-			PhysicalConfig:  nil,
-			VariableContext: nil,
+			PhysicalConfig:            nil,
+			VariableContext:           nil,
+			RecursiveCTEMaxIterations: maxRecursionIterations,
+			LateRecords:               lateRecords,
+			WatermarkIdleTimeout:      watermarkIdleTimeout,
+			MemoryBudget:              memoryBudget,
+			DisableHashJoins:          !hashJoins,
+			LookupJoinConcurrency:     lookupJoinConcurrency,
+			LookupJoinCacheSize:       lookupJoinCacheSize,
+			LookupJoinCacheTTL:        lookupJoinCacheTTL,
+			Parallelism:               parallelism,
 		}
 		statement, err := sqlparser.Parse(args[0])
 		if err != nil {
@@ -236,6 +268,9 @@ octosql "SELECT * FROM plugins.plugins"`,
 		tableValuedFunctions := map[string]logical.TableValuedFunctionDescription{
 			"max_diff_watermark": table_valued_functions.MaxDiffWatermark,
 			"tumble":             table_valued_functions.Tumble,
+			"hop":                table_valued_functions.Hop,
+			"session":            table_valued_functions.Session,
+			"latest":             table_valued_functions.Latest,
 			"range":              table_valued_functions.Range,
 			"poll":               table_valued_functions.Poll,
 		}
@@ -285,12 +320,28 @@ octosql "SELECT * FROM plugins.plugins"`,
 			}
 			physicalLimitExpression = &physicalExpr
 		}
+		var physicalOffsetExpression *physical.Expression
+		if outputOptions.Offset != nil {
+			physicalExpr, err := typecheckExpr(ctx, *outputOptions.Offset, env.WithRecordSchema(physicalPlan.Schema), logical.Environment{
+				CommonTableExpressions: map[string]logical.CommonTableExpression{},
+				TableValuedFunctions:   tableValuedFunctions,
+				UniqueVariableNames: &logical.VariableMapping{
+					Mapping: mapping,
+				},
+				UniqueNameGenerator: uniqueNameGenerator,
+			})
+			if err != nil {
+				return fmt.Errorf("couldn't typecheck offset expression: %w", err)
+			}
+			physicalOffsetExpression = &physicalExpr
+		}
 
 		queryTelemetry := telemetry.GetQueryTelemetryData(physicalPlan, installedPlugins)
 
 		var executionPlan execution.Node
 		var orderByExpressions []execution.Expression
 		var limitExpression *execution.Expression
+		var offsetExpression *execution.Expression
 		var outSchema physical.Schema
 		if describe {
 			telemetry.SendTelemetry(ctx, VERSION, "describe", queryTelemetry)
@@ -357,6 +408,13 @@ octosql "SELECT * FROM plugins.plugins"`,
 				}
 				limitExpression = &execExpr
 			}
+			if physicalOffsetExpression != nil {
+				execExpr, err := physicalOffsetExpression.Materialize(ctx, env.WithRecordSchema(physicalPlan.Schema))
+				if err != nil {
+					return fmt.Errorf("couldn't materialize output offset expression: %w", err)
+				}
+				offsetExpression = &execExpr
+			}
 
 			outFields := make([]physical.SchemaField, len(physicalPlan.Schema.Fields))
 			copy(outFields, physicalPlan.Schema.Fields)
@@ -390,11 +448,23 @@ octosql "SELECT * FROM plugins.plugins"`,
 					return fmt.Errorf("limit must be positive, got %d", val.Int)
 				}
 				limit = &val.Int
-
-				if len(orderByExpressions) == 0 && physicalPlan.Schema.NoRetractions {
-					// We want short-circuiting.
-					executionPlan = nodes.NewLimit(executionPlan, *limitExpression)
+			}
+			var offset *int
+			if offsetExpression != nil {
+				val, err := (*offsetExpression).Evaluate(execCtx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate offset expression: %w", err)
+				}
+				if val.Int < 0 {
+					return fmt.Errorf("offset must be non-negative, got %d", val.Int)
 				}
+				offset = &val.Int
+			}
+			if limitExpression != nil && len(orderByExpressions) == 0 && physicalPlan.Schema.NoRetractions {
+				// We want short-circuiting.
+				executionPlan = nodes.NewLimit(executionPlan, *limitExpression, offsetExpression)
+				// The offset has already been applied by the limit node.
+				offset = nil
 			}
 
 			sink = batch.NewOutputPrinter(
@@ -402,6 +472,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 				orderByExpressions,
 				logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 				limit,
+				offset,
 				physicalPlan.Schema.NoRetractions,
 				outSchema,
 				func(writer io.Writer) batch.Format {
@@ -411,9 +482,9 @@ octosql "SELECT * FROM plugins.plugins"`,
 			)
 		case "csv", "json":
 			if len(orderByExpressions) > 0 || (limitExpression != nil && !physicalPlan.Schema.NoRetractions) {
-				executionPlan = nodes.NewOrderSensitiveTransform(executionPlan, orderByExpressions, logical.DirectionsToMultipliers(outputOptions.OrderByDirections), limitExpression, physicalPlan.Schema.NoRetractions)
+				executionPlan = nodes.NewOrderSensitiveTransform(executionPlan, orderByExpressions, logical.DirectionsToMultipliers(outputOptions.OrderByDirections), limitExpression, offsetExpression, physicalPlan.Schema.NoRetractions, env.MemoryBudget)
 			} else if limitExpression != nil {
-				executionPlan = nodes.NewLimit(executionPlan, *limitExpression)
+				executionPlan = nodes.NewLimit(executionPlan, *limitExpression, offsetExpression)
 			}
 
 			var formatter func(writer io.Writer) eager.Format
@@ -436,9 +507,9 @@ octosql "SELECT * FROM plugins.plugins"`,
 
 		case "stream_native":
 			if len(orderByExpressions) > 0 || (limitExpression != nil && !physicalPlan.Schema.NoRetractions) {
-				executionPlan = nodes.NewOrderSensitiveTransform(executionPlan, orderByExpressions, logical.DirectionsToMultipliers(outputOptions.OrderByDirections), limitExpression, physicalPlan.Schema.NoRetractions)
+				executionPlan = nodes.NewOrderSensitiveTransform(executionPlan, orderByExpressions, logical.DirectionsToMultipliers(outputOptions.OrderByDirections), limitExpression, offsetExpression, physicalPlan.Schema.NoRetractions, env.MemoryBudget)
 			} else if limitExpression != nil {
-				executionPlan = nodes.NewLimit(executionPlan, *limitExpression)
+				executionPlan = nodes.NewLimit(executionPlan, *limitExpression, offsetExpression)
 			}
 
 			sink = stream.NewOutputPrinter(
@@ -458,6 +529,9 @@ octosql "SELECT * FROM plugins.plugins"`,
 		); err != nil {
 			return fmt.Errorf("couldn't run query: %w", err)
 		}
+		if count := lateRecords.Count(); count > 0 {
+			fmt.Fprintf(os.Stderr, "Records dropped for arriving later than the allowed lateness: %d\n", count)
+		}
 		return nil
 	},
 }
@@ -466,18 +540,67 @@ func Execute(ctx context.Context) {
 	cobra.CheckErr(rootCmd.ExecuteContext(ctx))
 }
 
+var allowedLateness time.Duration
 var describe bool
 var explain int
+var hashJoins bool
+var lateRecordsOutputPath string
+var lookupJoinCacheSizeStr string
+var lookupJoinCacheTTL time.Duration
+var lookupJoinConcurrency int
+var maxRecursionIterations int
+var memoryBudgetStr string
 var optimize bool
 var output string
+var parallelism int
 var prof string
+var watermarkIdleTimeout time.Duration
 
 func init() {
+	rootCmd.Flags().DurationVar(&allowedLateness, "allowed-lateness", 0, "How far behind the watermark records may arrive and still update the results. Later records get dropped.")
 	rootCmd.Flags().BoolVar(&describe, "describe", false, "Describe query output schema.")
 	rootCmd.Flags().IntVar(&explain, "explain", 0, "Describe query output schema.")
+	rootCmd.Flags().BoolVar(&hashJoins, "hash-joins", true, "Whether joins of inputs with no retractions and no time field should use hash joins, which keep the input finishing first in a hash table, instead of stream joins.")
+	rootCmd.Flags().StringVar(&lateRecordsOutputPath, "late-records-output", "", "File to write the records dropped for arriving later than the allowed lateness to, as JSON.")
+	rootCmd.Flags().StringVar(&lookupJoinCacheSizeStr, "lookup-join-cache-size", "64MB", "Memory each lookup join may use to cache the joined rows by the values of the source fields they depend on. Disabled if zero.")
+	rootCmd.Flags().DurationVar(&lookupJoinCacheTTL, "lookup-join-cache-ttl", 0, "How long the rows cached by lookup joins are valid. They never expire if zero.")
+	rootCmd.Flags().IntVar(&lookupJoinConcurrency, "lookup-join-concurrency", 8, "Maximum number of lookups each lookup join runs at the same time. The output keeps the order of the source records.")
+	rootCmd.Flags().IntVar(&maxRecursionIterations, "max-recursion-iterations", physical.DefaultRecursiveCTEMaxIterations, "Maximum number of iterations of a recursive common table expression.")
+	rootCmd.Flags().StringVar(&memoryBudgetStr, "memory-budget", "", "Memory budget for the state of each group by, distinct, join and order by, like 512MB. Larger state gets spilled to temporary files on disk. Joins don't use hash joins if set. Unlimited if empty.")
 	rootCmd.Flags().BoolVar(&optimize, "optimize", true, "Whether OctoSQL should optimize the query.")
 	rootCmd.Flags().StringVarP(&output, "output", "o", "live_table", "Output format to use. Available options are live_table, batch_table, csv, json and stream_native.")
+	rootCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Number of partitions to run group bys with in parallel, records get partitioned by their group key. The order of unsorted output isn't deterministic with more than one.")
 	rootCmd.Flags().StringVar(&prof, "profile", "", "Enable profiling of the given type: cpu, memory, trace.")
+	rootCmd.Flags().DurationVar(&watermarkIdleTimeout, "watermark-idle-timeout", 0, "How long an input of a join or union may not send anything before it stops holding back the watermark. Disabled if zero.")
+}
+
+// parseByteSize parses sizes like 512MB, using 1024 as the multiplier between units.
+func parseByteSize(size string) (int, error) {
+	if size == "" {
+		return 0, nil
+	}
+	upper := strings.ToUpper(strings.TrimSpace(size))
+	multiplier := 1
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int
+	}{
+		{"KB", 1 << 10},
+		{"MB", 1 << 20},
+		{"GB", 1 << 30},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(upper, unit.suffix) {
+			upper = strings.TrimSpace(strings.TrimSuffix(upper, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	value, err := strconv.Atoi(upper)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid size '%s', must be a non-negative number of bytes, optionally followed by KB, MB or GB", size)
+	}
+	return value * multiplier, nil
 }
 
 func typecheckNode(ctx context.Context, node logical.Node, env physical.Environment, logicalEnv logical.Environment) (_ physical.Node, _ map[string]string, outErr error) {
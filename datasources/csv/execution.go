@@ -24,6 +24,10 @@ type DatasourceExecuting struct {
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	return d.RunBatch(ctx, ProduceBatchRecords(produce), metaSend)
+}
+
+func (d *DatasourceExecuting) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
 	f, err := files.OpenLocalFile(ctx, d.path)
 	if err != nil {
 		return fmt.Errorf("couldn't open local file: %w", err)
@@ -52,6 +56,7 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 		}
 	}
 
+	batch := NewRecordBatch(len(indicesToRead))
 	for {
 		row, err := decoder.Read()
 		if err == io.EOF {
@@ -60,7 +65,7 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 			return fmt.Errorf("couldn't decode message: %w", err)
 		}
 
-		values := make([]octosql.Value, len(indicesToRead))
+		values := batch.Append(false, time.Time{})
 		for i, columnIndex := range indicesToRead {
 			str := row[columnIndex]
 			if str == "" {
@@ -103,8 +108,16 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 			values[i] = octosql.NewString(str)
 		}
 
-		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
-			return fmt.Errorf("couldn't produce record: %w", err)
+		if batch.Full() {
+			if err := produce(ProduceFromExecutionContext(ctx), batch); err != nil {
+				return fmt.Errorf("couldn't produce record batch: %w", err)
+			}
+			batch.Reset()
+		}
+	}
+	if batch.Len() > 0 {
+		if err := produce(ProduceFromExecutionContext(ctx), batch); err != nil {
+			return fmt.Errorf("couldn't produce record batch: %w", err)
 		}
 	}
 
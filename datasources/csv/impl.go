@@ -10,6 +10,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/cube2222/octosql/datasources/eventtime"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/files"
 	"github.com/cube2222/octosql/octosql"
@@ -18,6 +19,11 @@ import (
 
 func Creator(separator rune) func(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error) {
 	return func(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error) {
+		eventTime, err := eventtime.ParseOptions(options)
+		if err != nil {
+			return nil, physical.Schema{}, err
+		}
+
 		f, err := files.OpenLocalFile(context.Background(), name, files.WithPreview())
 		if err != nil {
 			return nil, physical.Schema{}, fmt.Errorf("couldn't open local file: %w", err)
@@ -139,13 +145,19 @@ func Creator(separator rune) func(name string, options map[string]string) (physi
 			}
 		}
 
+		schema, err := eventTime.Apply(physical.NewSchema(schemaFields, -1, physical.WithNoRetractions(true)))
+		if err != nil {
+			return nil, physical.Schema{}, err
+		}
+
 		return &impl{
 				path:           name,
 				header:         header,
 				separator:      separator,
 				fileFieldNames: fieldNames,
+				eventTime:      eventTime,
 			},
-			physical.NewSchema(schemaFields, -1, physical.WithNoRetractions(true)),
+			schema,
 			nil
 	}
 }
@@ -155,16 +167,17 @@ type impl struct {
 	header         bool
 	separator      rune
 	fileFieldNames []string
+	eventTime      eventtime.Options
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
-	return &DatasourceExecuting{
+	return i.eventTime.Materialize(&DatasourceExecuting{
 		path:           i.path,
 		fields:         schema.Fields,
 		header:         i.header,
 		separator:      i.separator,
 		fileFieldNames: i.fileFieldNames,
-	}, nil
+	}, schema, env.LateRecords), nil
 }
 
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
@@ -0,0 +1,111 @@
+package eventtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Options let file datasources declare their event time field directly,
+// instead of wrapping them in the max_diff_watermark table valued function.
+// With a time field, the datasource stamps the event time of its records
+// and sends watermarks lagging max_lateness behind the maximum event time seen so far.
+type Options struct {
+	TimeField   string
+	MaxLateness time.Duration
+}
+
+func ParseOptions(options map[string]string) (Options, error) {
+	var out Options
+	out.TimeField = options["time_field"]
+	if maxLatenessStr, ok := options["max_lateness"]; ok {
+		if out.TimeField == "" {
+			return Options{}, fmt.Errorf("max_lateness option requires the time_field option")
+		}
+		maxLateness, err := time.ParseDuration(maxLatenessStr)
+		if err != nil {
+			return Options{}, fmt.Errorf("couldn't parse max_lateness option, must be a duration like 10s: %w", err)
+		}
+		if maxLateness < 0 {
+			return Options{}, fmt.Errorf("max_lateness option must not be negative, is %s", maxLatenessStr)
+		}
+		out.MaxLateness = maxLateness
+	}
+	return out, nil
+}
+
+// Apply sets the time field of the schema, if there is one.
+func (o Options) Apply(schema physical.Schema) (physical.Schema, error) {
+	if o.TimeField == "" {
+		return schema, nil
+	}
+	for i, field := range schema.Fields {
+		if field.Name != o.TimeField {
+			continue
+		}
+		if field.Type.TypeID != octosql.TypeIDTime {
+			return physical.Schema{}, fmt.Errorf("time_field must reference field with type Time, is %s", field.Type.String())
+		}
+		schema.TimeField = i
+		return schema, nil
+	}
+	return physical.Schema{}, fmt.Errorf("no %s field in datasource", o.TimeField)
+}
+
+// Materialize wraps the source with a watermark generator, if the schema has a time field.
+// The schema should be the one passed to the datasource Materialize, as unused fields may have been removed from it.
+func (o Options) Materialize(source execution.Node, schema physical.Schema, lateRecords *execution.LateRecords) execution.Node {
+	if schema.TimeField == -1 {
+		return source
+	}
+	return &watermarkGenerator{
+		source:         source,
+		maxLateness:    o.MaxLateness,
+		timeFieldIndex: schema.TimeField,
+		lateRecords:    lateRecords,
+	}
+}
+
+// Watermarks are rounded down to this resolution, so that they're not sent with every record.
+const resolution = time.Second
+
+type watermarkGenerator struct {
+	source         execution.Node
+	maxLateness    time.Duration
+	timeFieldIndex int
+	// Records behind the watermark are passed on only if they're within the allowed lateness.
+	lateRecords *execution.LateRecords
+}
+
+func (w *watermarkGenerator) Run(ctx execution.ExecutionContext, produce execution.ProduceFn, metaSend execution.MetaSendFn) error {
+	maxValue := time.Time{}
+	curWatermark := time.Time{}
+
+	return w.source.Run(ctx, func(ctx execution.ProduceContext, record execution.Record) error {
+		record.EventTime = record.Values[w.timeFieldIndex].Time
+		if w.lateRecords.TooLate(record.EventTime, curWatermark) {
+			if err := w.lateRecords.Drop(record); err != nil {
+				return fmt.Errorf("couldn't drop late record: %w", err)
+			}
+		} else if err := produce(ctx, record); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+
+		if roundedDown := record.EventTime.Truncate(resolution); roundedDown.After(maxValue) {
+			maxValue = roundedDown
+			curWatermark = roundedDown.Add(-w.maxLateness)
+
+			if err := metaSend(ctx, execution.MetadataMessage{
+				Type:      execution.MetadataMessageTypeWatermark,
+				Watermark: curWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send updated watermark: %w", err)
+			}
+		}
+
+		return nil
+	}, metaSend)
+}
@@ -0,0 +1,55 @@
+package json
+
+import (
+	"fmt"
+
+	"github.com/valyala/fastjson"
+)
+
+// Debezium change events describe a change of a single row.
+// The op field is c for inserts, u for updates, d for deletes and r for rows read during a snapshot.
+// The before and after fields contain the row before and after the change, null if not applicable.
+// The event may be wrapped in a schema/payload envelope, depending on the converter configuration.
+// Tombstones, which are null events sent after deletes for log compaction, are skipped.
+type debeziumChange struct {
+	before, after *fastjson.Object
+}
+
+func parseDebeziumChange(o *fastjson.Object) (debeziumChange, error) {
+	if payload := o.Get("payload"); payload != nil && payload.Type() == fastjson.TypeObject {
+		o, _ = payload.Object()
+	}
+	opValue := o.Get("op")
+	if opValue == nil || opValue.Type() != fastjson.TypeString {
+		return debeziumChange{}, fmt.Errorf("change event has no op field")
+	}
+	op, _ := opValue.StringBytes()
+
+	getRow := func(name string) (*fastjson.Object, error) {
+		row := o.Get(name)
+		if row == nil || row.Type() != fastjson.TypeObject {
+			return nil, fmt.Errorf("'%s' change event has no %s row", op, name)
+		}
+		return row.Object()
+	}
+
+	var out debeziumChange
+	var err error
+	switch string(op) {
+	case "c", "r":
+		out.after, err = getRow("after")
+	case "u":
+		if out.before, err = getRow("before"); err != nil {
+			return debeziumChange{}, fmt.Errorf("%w, the source table must send full rows before updates", err)
+		}
+		out.after, err = getRow("after")
+	case "d":
+		out.before, err = getRow("before")
+	default:
+		return debeziumChange{}, fmt.Errorf("unknown change event op: '%s'", op)
+	}
+	if err != nil {
+		return debeziumChange{}, err
+	}
+	return out, nil
+}
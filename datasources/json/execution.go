@@ -14,12 +14,18 @@ import (
 )
 
 type DatasourceExecuting struct {
-	path   string
-	tail   bool
-	fields []physical.SchemaField
+	path string
+	tail bool
+	// Lines are Debezium change events, instead of rows.
+	changelog bool
+	fields    []physical.SchemaField
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	return d.RunBatch(ctx, ProduceBatchRecords(produce), metaSend)
+}
+
+func (d *DatasourceExecuting) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
 	f, err := files.OpenLocalFile(ctx, d.path, files.WithTail(d.tail))
 	if err != nil {
 		return fmt.Errorf("couldn't open local file: %w", err)
@@ -29,12 +35,24 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 	sc := bufio.NewScanner(f)
 	sc.Buffer(nil, 1024*1024)
 
+	batch := NewRecordBatch(len(d.fields))
+	flush := func() error {
+		if err := produce(ProduceFromExecutionContext(ctx), batch); err != nil {
+			return fmt.Errorf("couldn't produce record batch: %w", err)
+		}
+		batch.Reset()
+		return nil
+	}
+
 	var p fastjson.Parser
 	for sc.Scan() {
 		v, err := p.ParseBytes(sc.Bytes())
 		if err != nil {
 			return fmt.Errorf("couldn't parse json: %w", err)
 		}
+		if d.changelog && v.Type() == fastjson.TypeNull {
+			continue
+		}
 		if v.Type() != fastjson.TypeObject {
 			return fmt.Errorf("expected JSON object, got '%s'", sc.Text())
 		}
@@ -43,16 +61,41 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 			return fmt.Errorf("expected JSON object, got '%s'", sc.Text())
 		}
 
-		values := make([]octosql.Value, len(d.fields))
-		for i := range values {
-			values[i], _ = getOctoSQLValue(d.fields[i].Type, o.Get(d.fields[i].Name))
+		if !d.changelog {
+			d.getValues(o, batch.Append(false, time.Time{}))
+		} else {
+			change, err := parseDebeziumChange(o)
+			if err != nil {
+				return fmt.Errorf("couldn't parse change event: %w", err)
+			}
+			if change.before != nil {
+				d.getValues(change.before, batch.Append(true, time.Time{}))
+			}
+			if change.after != nil {
+				d.getValues(change.after, batch.Append(false, time.Time{}))
+			}
 		}
 
-		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
-			return fmt.Errorf("couldn't produce record: %w", err)
+		// When tailing, the next line may take arbitrarily long to arrive, so records can't wait for a full batch.
+		if batch.Full() || d.tail {
+			if err := flush(); err != nil {
+				return err
+			}
 		}
 	}
-	return sc.Err()
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if batch.Len() > 0 {
+		return flush()
+	}
+	return nil
+}
+
+func (d *DatasourceExecuting) getValues(o *fastjson.Object, values []octosql.Value) {
+	for i := range values {
+		values[i], _ = getOctoSQLValue(d.fields[i].Type, o.Get(d.fields[i].Name))
+	}
 }
 
 func getOctoSQLValue(t octosql.Type, value *fastjson.Value) (out octosql.Value, ok bool) {
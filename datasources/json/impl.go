@@ -9,6 +9,7 @@ import (
 
 	"github.com/valyala/fastjson"
 
+	"github.com/cube2222/octosql/datasources/eventtime"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/files"
 	"github.com/cube2222/octosql/octosql"
@@ -16,6 +17,20 @@ import (
 )
 
 func Creator(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error) {
+	eventTime, err := eventtime.ParseOptions(options)
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
+	changelog := false
+	switch options["changelog"] {
+	case "":
+	case "debezium":
+		changelog = true
+	default:
+		return nil, physical.Schema{}, fmt.Errorf("unknown changelog option value '%s', the only supported one is debezium", options["changelog"])
+	}
+
 	f, err := files.OpenLocalFile(context.Background(), name, files.WithPreview())
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open local file: %w", err)
@@ -35,6 +50,9 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 		if err != nil {
 			return nil, physical.Schema{}, fmt.Errorf("couldn't parse json: %w", err)
 		}
+		if changelog && v.Type() == fastjson.TypeNull {
+			continue
+		}
 		if v.Type() != fastjson.TypeObject {
 			return nil, physical.Schema{}, fmt.Errorf("expected JSON object, got '%s'", sc.Text())
 		}
@@ -43,13 +61,27 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 			return nil, physical.Schema{}, fmt.Errorf("expected JSON object, got '%s'", sc.Text())
 		}
 
-		o.Visit(func(key []byte, v *fastjson.Value) {
+		visitor := func(key []byte, v *fastjson.Value) {
 			if t, ok := fields[string(key)]; ok {
 				fields[string(key)] = octosql.TypeSum(t, getOctoSQLType(v))
 			} else {
 				fields[string(key)] = getOctoSQLType(v)
 			}
-		})
+		}
+		if !changelog {
+			o.Visit(visitor)
+			continue
+		}
+		change, err := parseDebeziumChange(o)
+		if err != nil {
+			return nil, physical.Schema{}, fmt.Errorf("couldn't parse change event: %w", err)
+		}
+		if change.before != nil {
+			change.before.Visit(visitor)
+		}
+		if change.after != nil {
+			change.after.Visit(visitor)
+		}
 	}
 	if sc.Err() != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't scan lines: %w", sc.Err())
@@ -66,11 +98,19 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 		return schemaFields[i].Name < schemaFields[j].Name
 	})
 
+	// Updates and deletes in changelogs are sent as retractions.
+	schema, err := eventTime.Apply(physical.NewSchema(schemaFields, -1, physical.WithNoRetractions(!changelog)))
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
 	return &impl{
-			path: name,
-			tail: options["tail"] == "true",
+			path:      name,
+			tail:      options["tail"] == "true",
+			changelog: changelog,
+			eventTime: eventTime,
 		},
-		physical.NewSchema(schemaFields, -1, physical.WithNoRetractions(true)),
+		schema,
 		nil
 }
 
@@ -131,16 +171,19 @@ func getOctoSQLType(value *fastjson.Value) octosql.Type {
 }
 
 type impl struct {
-	path string
-	tail bool
+	path      string
+	tail      bool
+	changelog bool
+	eventTime eventtime.Options
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
-	return &DatasourceExecuting{
-		path:   i.path,
-		tail:   i.tail,
-		fields: schema.Fields,
-	}, nil
+	return i.eventTime.Materialize(&DatasourceExecuting{
+		path:      i.path,
+		tail:      i.tail,
+		changelog: i.changelog,
+		fields:    schema.Fields,
+	}, schema, env.LateRecords), nil
 }
 
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
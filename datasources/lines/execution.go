@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/cube2222/octosql/execution"
@@ -16,9 +17,15 @@ type DatasourceExecuting struct {
 	path, separator string
 	fields          []physical.SchemaField
 	tail            bool
+	// Layout of the time at the beginning of each line, if it has a time field.
+	timeFormat string
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	return d.RunBatch(ctx, ProduceBatchRecords(produce), metaSend)
+}
+
+func (d *DatasourceExecuting) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
 	f, err := files.OpenLocalFile(ctx, d.path, files.WithTail(d.tail))
 	if err != nil {
 		return fmt.Errorf("couldn't open local file: %w", err)
@@ -45,25 +52,66 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 		})
 	}
 
+	batch := NewRecordBatch(len(d.fields))
+	flush := func() error {
+		if err := produce(ProduceFromExecutionContext(ctx), batch); err != nil {
+			return fmt.Errorf("couldn't produce record batch: %w", err)
+		}
+		batch.Reset()
+		return nil
+	}
+
 	line := 0
 	for sc.Scan() {
-		values := make([]octosql.Value, len(d.fields))
+		values := batch.Append(false, time.Time{})
 		for i := range d.fields {
 			switch d.fields[i].Name {
 			case "number":
 				values[i] = octosql.NewInt(line)
 			case "text":
 				values[i] = octosql.NewString(sc.Text())
+			case "time":
+				t, err := parseLeadingTime(d.timeFormat, sc.Text())
+				if err != nil {
+					return fmt.Errorf("couldn't parse time at the beginning of line %d: %w", line, err)
+				}
+				values[i] = octosql.NewTime(t)
 			}
 		}
+		line++
 
-		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
-			return fmt.Errorf("couldn't produce record: %w", err)
+		// When tailing, the next line may take arbitrarily long to arrive, so records can't wait for a full batch.
+		if batch.Full() || d.tail {
+			if err := flush(); err != nil {
+				return err
+			}
 		}
-		line++
 	}
 	if sc.Err() != nil {
 		return err
 	}
+	if batch.Len() > 0 {
+		return flush()
+	}
 	return nil
 }
+
+// parseLeadingTime parses the time at the beginning of the line.
+// The time is made up of as many space-separated words as the layout.
+func parseLeadingTime(layout, text string) (time.Time, error) {
+	words := strings.Count(layout, " ") + 1
+	end := 0
+	for i := 0; i < words; i++ {
+		next := strings.IndexByte(text[end:], ' ')
+		if next == -1 {
+			end = len(text)
+			break
+		}
+		if i < words-1 {
+			end += next + 1
+		} else {
+			end += next
+		}
+	}
+	return time.Parse(layout, text[:end])
+}
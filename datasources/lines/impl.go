@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cube2222/octosql/datasources/eventtime"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/files"
 	"github.com/cube2222/octosql/octosql"
@@ -11,6 +12,11 @@ import (
 )
 
 func Creator(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error) {
+	eventTime, err := eventtime.ParseOptions(options)
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
 	f, err := files.OpenLocalFile(context.Background(), name, files.WithPreview())
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open local file: %w", err)
@@ -22,40 +28,61 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 		separator = sep
 	}
 
+	fields := []physical.SchemaField{
+		{
+			Name: "number",
+			Type: octosql.Int,
+		},
+		{
+			Name: "text",
+			Type: octosql.String,
+		},
+	}
+	timeFormat := options["time_format"]
+	if timeFormat != "" {
+		fields = append(fields, physical.SchemaField{
+			Name: "time",
+			Type: octosql.Time,
+		})
+	} else if eventTime.TimeField != "" {
+		return nil, physical.Schema{}, fmt.Errorf("time_field option requires the time_format option for lines files, so that the time field can be parsed from the beginning of each line")
+	}
+
+	schema, err := eventTime.Apply(physical.NewSchema(
+		fields,
+		-1,
+		physical.WithNoRetractions(true),
+	))
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
 	return &impl{
-			path:      name,
-			separator: separator,
-			tail:      options["tail"] == "true",
+			path:       name,
+			separator:  separator,
+			tail:       options["tail"] == "true",
+			timeFormat: timeFormat,
+			eventTime:  eventTime,
 		},
-		physical.NewSchema(
-			[]physical.SchemaField{
-				{
-					Name: "number",
-					Type: octosql.Int,
-				},
-				{
-					Name: "text",
-					Type: octosql.String,
-				},
-			},
-			-1,
-			physical.WithNoRetractions(true),
-		),
+		schema,
 		nil
 }
 
 type impl struct {
 	path, separator string
 	tail            bool
+	timeFormat      string
+	eventTime       eventtime.Options
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
-	return &DatasourceExecuting{
-		path:      i.path,
-		fields:    schema.Fields,
-		separator: i.separator,
-		tail:      i.tail,
-	}, nil
+	return i.eventTime.Materialize(&DatasourceExecuting{
+		path:       i.path,
+		fields:     schema.Fields,
+		separator:  i.separator,
+		tail:       i.tail,
+		timeFormat: i.timeFormat,
+	}, schema, env.LateRecords), nil
 }
 
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
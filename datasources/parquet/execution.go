@@ -19,6 +19,10 @@ type DatasourceExecuting struct {
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	return d.RunBatch(ctx, ProduceBatchRecords(produce), metaSend)
+}
+
+func (d *DatasourceExecuting) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
 	f, err := os.Open(d.path)
 	if err != nil {
 		return fmt.Errorf("couldn't open file: %w", err)
@@ -40,6 +44,15 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 	pf.Schema().MakeColumnReadRowFunc(usedFields)
 	reconstruct := reconstructFuncOfSchemaFields(pf.Schema(), usedFields)
 
+	batch := NewRecordBatch(len(d.fields))
+	flush := func() error {
+		if err := produce(ProduceFromExecutionContext(ctx), batch); err != nil {
+			return fmt.Errorf("couldn't produce record batch: %w", err)
+		}
+		batch.Reset()
+		return nil
+	}
+
 	var row parquet.Row
 	pr := parquet.NewReader(pf)
 	if len(usedFields) > 0 {
@@ -55,18 +68,28 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 			if _, err := reconstruct(&value, levels{}, row); err != nil {
 				return fmt.Errorf("couldn't reconstruct value from row: %w", err)
 			}
-			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(value.Struct, false, time.Time{})); err != nil {
-				return fmt.Errorf("couldn't produce value: %w", err)
+			// The reconstructed struct is allocated for each row anyway, so it's used as the record values directly.
+			batch.Add(NewRecord(value.Struct, false, time.Time{}))
+			if batch.Full() {
+				if err := flush(); err != nil {
+					return err
+				}
 			}
 		}
 	} else {
 		rowCount := int(pr.NumRows())
 		for i := 0; i < rowCount; i++ {
-			if err := produce(ProduceFromExecutionContext(ctx), NewRecord([]octosql.Value{}, false, time.Time{})); err != nil {
-				return fmt.Errorf("couldn't produce value: %w", err)
+			batch.Append(false, time.Time{})
+			if batch.Full() {
+				if err := flush(); err != nil {
+					return err
+				}
 			}
 		}
 	}
+	if batch.Len() > 0 {
+		return flush()
+	}
 
 	return nil
 }
@@ -7,12 +7,18 @@ import (
 
 	"github.com/segmentio/parquet-go"
 
+	"github.com/cube2222/octosql/datasources/eventtime"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
 func Creator(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error) {
+	eventTime, err := eventtime.ParseOptions(options)
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
 	f, err := os.Open(name)
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open file: %w", err)
@@ -43,10 +49,16 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 		}
 	}
 
+	outSchema, err := eventTime.Apply(physical.NewSchema(outSchemaFields, -1, physical.WithNoRetractions(true)))
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+
 	return &impl{
-			path: name,
+			path:      name,
+			eventTime: eventTime,
 		},
-		physical.NewSchema(outSchemaFields, -1, physical.WithNoRetractions(true)),
+		outSchema,
 		nil
 }
 
@@ -127,14 +139,15 @@ func getOctoSQLNode(node parquet.Node) (octosql.Type, bool) {
 }
 
 type impl struct {
-	path string
+	path      string
+	eventTime eventtime.Options
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
-	return &DatasourceExecuting{
+	return i.eventTime.Materialize(&DatasourceExecuting{
 		path:   i.path,
 		fields: schema.Fields,
-	}, nil
+	}, schema, env.LateRecords), nil
 }
 
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
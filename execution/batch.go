@@ -0,0 +1,98 @@
+package execution
+
+import (
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// DefaultBatchSize is the number of records batch producers put into a single batch.
+const DefaultBatchSize = 1024
+
+// RecordBatch is a group of records passed between nodes in a single call.
+// The batch and the top-level value slices of its records belong to the producer,
+// which reuses them after the produce call returns, so consumers which keep records around have to copy their values.
+// Nested values, like lists and structs, are never reused.
+type RecordBatch struct {
+	Records []Record
+
+	fieldCount int
+	values     []octosql.Value
+}
+
+func NewRecordBatch(fieldCount int) *RecordBatch {
+	return &RecordBatch{
+		Records:    make([]Record, 0, DefaultBatchSize),
+		fieldCount: fieldCount,
+	}
+}
+
+// Reset empties the batch, so that it can be filled again.
+func (b *RecordBatch) Reset() {
+	b.Records = b.Records[:0]
+	b.values = b.values[:0]
+}
+
+func (b *RecordBatch) Len() int {
+	return len(b.Records)
+}
+
+// Full returns whether the batch has reached the default batch size.
+func (b *RecordBatch) Full() bool {
+	return len(b.Records) >= DefaultBatchSize
+}
+
+// Append adds a record to the batch and returns its value slice, which the caller has to fill in.
+func (b *RecordBatch) Append(retraction bool, eventTime time.Time) []octosql.Value {
+	if len(b.values)+b.fieldCount > cap(b.values) {
+		// The previous storage is still used by the records already in the batch.
+		b.values = make([]octosql.Value, 0, b.fieldCount*DefaultBatchSize)
+	}
+	start := len(b.values)
+	b.values = b.values[:start+b.fieldCount]
+	values := b.values[start : start+b.fieldCount : start+b.fieldCount]
+	b.Records = append(b.Records, NewRecord(values, retraction, eventTime))
+	return values
+}
+
+// Add adds a record to the batch, using its own value slice.
+func (b *RecordBatch) Add(record Record) {
+	b.Records = append(b.Records, record)
+}
+
+type ProduceBatchFn func(ctx ProduceContext, batch *RecordBatch) error
+
+// BatchNode is implemented by nodes which can produce their records in batches.
+type BatchNode interface {
+	Node
+	RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error
+}
+
+// RunBatch runs the node, producing its records in batches.
+// Nodes which don't implement BatchNode get adapted, producing a batch for each record.
+func RunBatch(node Node, ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
+	if batchNode, ok := node.(BatchNode); ok {
+		return batchNode.RunBatch(ctx, produce, metaSend)
+	}
+
+	batch := &RecordBatch{Records: make([]Record, 1)}
+	return node.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		batch.Records[0] = record
+		return produce(produceCtx, batch)
+	}, metaSend)
+}
+
+// ProduceBatchRecords adapts a record produce function to batches, so that batch nodes can implement Run using RunBatch.
+// The values of the records get copied, as consumers of single records may keep them.
+func ProduceBatchRecords(produce ProduceFn) ProduceBatchFn {
+	return func(ctx ProduceContext, batch *RecordBatch) error {
+		for _, record := range batch.Records {
+			values := make([]octosql.Value, len(record.Values))
+			copy(values, record.Values)
+			if err := produce(ctx, NewRecord(values, record.Retraction, record.EventTime)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
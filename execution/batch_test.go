@@ -0,0 +1,43 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+func TestRecordBatch(t *testing.T) {
+	batch := NewRecordBatch(2)
+	// Going over the default batch size allocates more storage, without moving the records already in the batch.
+	for i := 0; i < DefaultBatchSize+10; i++ {
+		values := batch.Append(i%2 == 1, time.Time{})
+		values[0] = octosql.NewInt(i)
+		values[1] = octosql.NewString("a")
+	}
+	require.Equal(t, DefaultBatchSize+10, batch.Len())
+	assert.True(t, batch.Full())
+	for i, record := range batch.Records {
+		assert.Equal(t, []octosql.Value{octosql.NewInt(i), octosql.NewString("a")}, record.Values)
+		assert.Equal(t, i%2 == 1, record.Retraction)
+	}
+
+	var produced []Record
+	require.NoError(t, ProduceBatchRecords(func(ctx ProduceContext, record Record) error {
+		produced = append(produced, record)
+		return nil
+	})(ProduceContext{}, batch))
+
+	// The produced records keep their values after the batch gets reused.
+	batch.Reset()
+	assert.Equal(t, 0, batch.Len())
+	values := batch.Append(false, time.Time{})
+	values[0] = octosql.NewInt(-1)
+	require.Len(t, produced, DefaultBatchSize+10)
+	for i, record := range produced {
+		assert.Equal(t, octosql.NewInt(i), record.Values[0])
+	}
+}
@@ -1,7 +1,11 @@
 package execution
 
 import (
+	"crypto/rand"
 	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
 
 	"github.com/cube2222/octosql/octosql"
 )
@@ -72,8 +76,6 @@ func (c *TypeAssertion) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	}
 
 	return octosql.ZeroValue, fmt.Errorf("invalid type: %s, expected: %s", value.TypeID.String(), c.expectedTypeName)
-
-	return value, nil
 }
 
 type TypeCast struct {
@@ -253,6 +255,52 @@ func (e *MultiColumnQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Val
 	return octosql.NewList(values), nil
 }
 
+type ExistsQueryExpression struct {
+	source        Node
+	not           bool
+	noRetractions bool
+}
+
+func NewExistsQueryExpression(source Node, not bool, noRetractions bool) *ExistsQueryExpression {
+	return &ExistsQueryExpression{
+		source:        source,
+		not:           not,
+		noRetractions: noRetractions,
+	}
+}
+
+func (e *ExistsQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	// If the source can't retract records, the first record is enough to know the answer,
+	// so we stop the source right away. Otherwise, we have to track the count of records until the end.
+	stopID := ulid.MustNew(ulid.Now(), rand.Reader).String()
+
+	count := 0
+	if err := e.source.Run(
+		ctx,
+		func(ctx ProduceContext, record Record) error {
+			if record.Retraction {
+				count--
+				return nil
+			}
+			count++
+			if e.noRetractions {
+				// This error is returned to stop underlying processing.
+				// It will be caught and silenced by the expression that emitted it.
+				return fmt.Errorf("exists %s found a record", stopID)
+			}
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error { return nil },
+	); err != nil {
+		// We can't Unwrap because gRPC doesn't propagate wrapped errors, so we can't Unwrap over the plugin barrier.
+		if !strings.Contains(err.Error(), fmt.Sprintf("exists %s found a record", stopID)) {
+			return octosql.ZeroValue, fmt.Errorf("couldn't run exists subquery: %w", err)
+		}
+	}
+
+	return octosql.NewBoolean((count > 0) != e.not), nil
+}
+
 type LayoutMapping struct {
 	Struct *struct {
 		SourceIndex   []int
@@ -432,6 +480,45 @@ func (c *Coalesce) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	return octosql.NewNull(), nil
 }
 
+type Case struct {
+	conditions        []Expression
+	results           []Expression
+	elseResult        Expression
+	objectLayoutFixer *ObjectLayoutFixer
+}
+
+func NewCase(conditions []Expression, results []Expression, elseResult Expression, objectLayoutFixer *ObjectLayoutFixer) *Case {
+	return &Case{
+		conditions:        conditions,
+		results:           results,
+		elseResult:        elseResult,
+		objectLayoutFixer: objectLayoutFixer,
+	}
+}
+
+func (c *Case) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	// Only the result of the first matching branch gets evaluated.
+	for i := range c.conditions {
+		condition, err := c.conditions[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE condition: %w", i, err)
+		}
+		if condition.TypeID != octosql.TypeIDBoolean || !condition.Boolean {
+			continue
+		}
+		value, err := c.results[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE result: %w", i, err)
+		}
+		return c.objectLayoutFixer.FixLayout(i, value), nil
+	}
+	value, err := c.elseResult.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate CASE else result: %w", err)
+	}
+	return c.objectLayoutFixer.FixLayout(len(c.results), value), nil
+}
+
 type Tuple struct {
 	args []Expression
 }
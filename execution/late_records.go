@@ -0,0 +1,68 @@
+package execution
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LateRecords decides what happens with records which arrive with an event time behind the watermark.
+// Records which are at most the allowed lateness behind the watermark still get processed,
+// updating any already sent results through retractions.
+// Records which are later than that get dropped, counted, and written to the late records output, if there is one.
+// A nil *LateRecords allows no lateness and drops late records without recording them.
+type LateRecords struct {
+	allowedLateness time.Duration
+	output          func(record Record) error
+
+	mutex sync.Mutex
+	count int
+}
+
+func NewLateRecords(allowedLateness time.Duration, output func(record Record) error) *LateRecords {
+	return &LateRecords{
+		allowedLateness: allowedLateness,
+		output:          output,
+	}
+}
+
+func (l *LateRecords) AllowedLateness() time.Duration {
+	if l == nil {
+		return 0
+	}
+	return l.allowedLateness
+}
+
+// TooLate checks if a record with the given event time is behind the watermark by more than the allowed lateness.
+func (l *LateRecords) TooLate(eventTime, watermark time.Time) bool {
+	return eventTime.Before(watermark.Add(-l.AllowedLateness()))
+}
+
+// Drop records that the record has been dropped for being too late.
+func (l *LateRecords) Drop(record Record) error {
+	if l == nil {
+		return nil
+	}
+	// Sources of joins and unions run concurrently.
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.count++
+	if l.output != nil {
+		if err := l.output(record); err != nil {
+			return fmt.Errorf("couldn't write late record: %w", err)
+		}
+	}
+	return nil
+}
+
+// Count returns the number of dropped records.
+func (l *LateRecords) Count() int {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.count
+}
@@ -1,12 +1,15 @@
 package nodes
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/google/btree"
+	tbtree "github.com/tidwall/btree"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
 	"github.com/cube2222/octosql/octosql"
 )
 
@@ -14,16 +17,27 @@ type CustomTriggerGroupBy struct {
 	aggregatePrototypes []func() Aggregate
 	aggregateExprs      []Expression
 	keyExprs            []Expression
+	groupingSets        [][]int
 	keyEventTimeIndex   int
-	source              Node
-	triggerPrototype    func() Trigger
+	// Keys with an event time older than the watermark minus the allowed lateness get expired:
+	// their state is dropped and records for them are handled as too late.
+	// Only applies if the key contains the event time.
+	lateRecords *LateRecords
+	// Once the keys in memory take up half of the memory budget, the state of keys not in memory gets spilled to disk,
+	// see customTriggerGroupByState. Disabled if zero.
+	memoryBudget     int
+	source           Node
+	triggerPrototype func() Trigger
 }
 
 func NewCustomTriggerGroupBy(
 	aggregatePrototypes []func() Aggregate,
 	aggregateExprs []Expression,
 	keyExprs []Expression,
+	groupingSets [][]int,
 	keyEventTimeIndex int,
+	lateRecords *LateRecords,
+	memoryBudget int,
 	source Node,
 	triggerPrototype func() Trigger,
 ) *CustomTriggerGroupBy {
@@ -31,8 +45,11 @@ func NewCustomTriggerGroupBy(
 		aggregatePrototypes: aggregatePrototypes,
 		aggregateExprs:      aggregateExprs,
 		keyExprs:            keyExprs,
+		groupingSets:        groupingSets,
 		keyEventTimeIndex:   keyEventTimeIndex,
-		source:              NewEventTimeBuffer(source),
+		lateRecords:         lateRecords,
+		memoryBudget:        memoryBudget,
+		source:              NewEventTimeBuffer(source, lateRecords),
 		triggerPrototype:    triggerPrototype,
 	}
 }
@@ -53,18 +70,58 @@ type aggregatesItem struct {
 	OverallRecordCount int
 }
 
+// groupingSetKeys returns the keys a record with the given key belongs to in each grouping set.
+// Values which aren't part of a grouping set are replaced by NULL, and the grouping set index gets appended.
+func groupingSetKeys(key GroupKey, groupingSets [][]int) []GroupKey {
+	if groupingSets == nil {
+		return []GroupKey{key}
+	}
+
+	out := make([]GroupKey, len(groupingSets))
+	for setIndex, keyIndices := range groupingSets {
+		setKey := make(GroupKey, len(key)+1)
+		for i := range key {
+			setKey[i] = octosql.NewNull()
+		}
+		for _, keyIndex := range keyIndices {
+			setKey[keyIndex] = key[keyIndex]
+		}
+		setKey[len(key)] = octosql.NewInt(setIndex)
+		out[setIndex] = setKey
+	}
+	return out
+}
+
 type previouslySentValuesItem struct {
 	GroupKey
 	Values    []octosql.Value
 	EventTime time.Time
 }
 
+type groupByExpirationItem struct {
+	Time time.Time
+	Key  GroupKey
+}
+
 func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
-	aggregates := btree.New(BTreeDefaultDegree)
-	previouslySentValues := btree.New(BTreeDefaultDegree)
+	state := newCustomTriggerGroupByState(g.memoryBudget)
+	defer state.close()
 	trigger := g.triggerPrototype()
+	var watermark time.Time
 
-	if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+	// Keys with an event time, ordered by it, so that they can be expired when the watermark passes it.
+	expirationQueue := tbtree.NewGenericOptions(func(a, b *groupByExpirationItem) bool {
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		return CompareValueSlices(a.Key, b.Key)
+	}, tbtree.Options{
+		NoLocks: true,
+	})
+	// Keys with an event time before this one have been expired.
+	var expiredBefore time.Time
+
+	receiveRecord := func(produceCtx ProduceContext, record Record) error {
 		ctx := ctx.WithRecord(record)
 
 		key := make(GroupKey, len(g.keyExprs))
@@ -75,6 +132,13 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 			}
 			key[i] = value
 		}
+		if g.keyEventTimeIndex != -1 && key[g.keyEventTimeIndex].Time.Before(expiredBefore) {
+			// The key has already been expired, so the record is too late.
+			if err := g.lateRecords.Drop(record); err != nil {
+				return fmt.Errorf("couldn't drop late record: %w", err)
+			}
+			return nil
+		}
 
 		aggregateInputs := make([]octosql.Value, len(g.aggregateExprs))
 		for i, expr := range g.aggregateExprs {
@@ -85,18 +149,18 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 			aggregateInputs[i] = value
 		}
 
-		{
-			item := aggregates.Get(key)
+		for _, key := range groupingSetKeys(key, g.groupingSets) {
+			item := state.aggregates.Get(key)
 			var itemTyped *aggregatesItem
 
-			if item == nil {
-				newAggregates := make([]Aggregate, len(g.aggregatePrototypes))
-				for i := range g.aggregatePrototypes {
-					newAggregates[i] = g.aggregatePrototypes[i]()
+			if item == nil && state.isFull() {
+				if err := state.spillAggregateInputs(key, record.Retraction, aggregateInputs); err != nil {
+					return err
 				}
-
-				itemTyped = &aggregatesItem{GroupKey: key, Aggregates: newAggregates, AggregatedSetSize: make([]int, len(g.aggregatePrototypes))}
-				aggregates.ReplaceOrInsert(itemTyped)
+			} else if item == nil {
+				itemTyped = newAggregatesItem(key, g.aggregatePrototypes)
+				state.aggregates.ReplaceOrInsert(itemTyped)
+				state.stateSize += spill.Size(key) + len(g.aggregatePrototypes)*aggregateStateSize
 			} else {
 				var ok bool
 				itemTyped, ok = item.(*aggregatesItem)
@@ -106,64 +170,167 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 				}
 			}
 
-			if !record.Retraction {
-				itemTyped.OverallRecordCount++
-			} else {
-				itemTyped.OverallRecordCount--
-			}
-			for i, expr := range g.aggregateExprs {
-				aggregateInput, err := expr.Evaluate(ctx)
-				if err != nil {
-					return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
-				}
+			if itemTyped != nil {
+				itemTyped.add(record.Retraction, aggregateInputs)
 
-				if aggregateInput.TypeID != octosql.TypeIDNull {
-					if !record.Retraction {
-						itemTyped.AggregatedSetSize[i]++
-					} else {
-						itemTyped.AggregatedSetSize[i]--
-					}
-					itemTyped.Aggregates[i].Add(record.Retraction, aggregateInput)
+				if itemTyped.OverallRecordCount == 0 {
+					state.deleteAggregates(key)
+					// TODO: Also delete from triggers somehow? But have to force a retraction in that case.
 				}
 			}
 
-			if itemTyped.OverallRecordCount == 0 {
-				aggregates.Delete(itemTyped)
-				// TODO: Also delete from triggers somehow? But have to force a retraction in that case.
-			}
-
 			trigger.KeyReceived(key)
+			if g.keyEventTimeIndex != -1 {
+				expirationQueue.Set(&groupByExpirationItem{Time: key[g.keyEventTimeIndex].Time, Key: key})
+			}
 		}
 
-		if err := g.trigger(ProduceFromExecutionContext(ctx), aggregates, previouslySentValues, trigger, record.EventTime, produce); err != nil {
+		if err := g.trigger(ProduceFromExecutionContext(ctx), state, trigger, record.EventTime, produce); err != nil {
 			return fmt.Errorf("couldn't trigger keys on record receive: %w", err)
 		}
 
 		return nil
-	}, func(ctx ProduceContext, msg MetadataMessage) error {
+	}
+	receiveMetadata := func(ctx ProduceContext, msg MetadataMessage) error {
 		if msg.Type == MetadataMessageTypeWatermark {
+			watermark = msg.Watermark
 			trigger.WatermarkReceived(msg.Watermark)
-			if err := g.trigger(ctx, aggregates, previouslySentValues, trigger, msg.Watermark, produce); err != nil {
+			if err := g.trigger(ctx, state, trigger, msg.Watermark, produce); err != nil {
 				return fmt.Errorf("couldn't trigger keys on watermark: %w", err)
 			}
+
+			if g.keyEventTimeIndex != -1 && msg.Watermark.Add(-g.lateRecords.AllowedLateness()).After(expiredBefore) {
+				expiredBefore = msg.Watermark.Add(-g.lateRecords.AllowedLateness())
+
+				var toExpire []GroupKey
+				for {
+					item, ok := expirationQueue.Min()
+					if !ok || !item.Time.Before(expiredBefore) {
+						break
+					}
+					expirationQueue.Delete(item)
+					toExpire = append(toExpire, item.Key)
+				}
+				// Values which have changed since they were last triggered are final now, so they have to be sent before the state is dropped.
+				if err := g.triggerKeys(ctx, state, toExpire, msg.Watermark, true, produce); err != nil {
+					return fmt.Errorf("couldn't trigger expired keys: %w", err)
+				}
+				for _, key := range toExpire {
+					if err := state.expire(key); err != nil {
+						return err
+					}
+					trigger.ExpireKey(key)
+				}
+			}
 		}
 		return metaSend(ctx, msg)
-	}); err != nil {
+	}
+
+	if processingTimeTrigger, ok := trigger.(ProcessingTimeTrigger); ok {
+		if err := g.runWithProcessingTimeTrigger(ctx, processingTimeTrigger, receiveRecord, receiveMetadata, func() error {
+			if err := g.trigger(ProduceFromExecutionContext(ctx), state, trigger, watermark, produce); err != nil {
+				return fmt.Errorf("couldn't trigger keys on deadline: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("couldn't run source: %w", err)
+		}
+	} else if err := g.source.Run(ctx, receiveRecord, receiveMetadata); err != nil {
 		return fmt.Errorf("couldn't run source: %w", err)
 	}
 
 	trigger.EndOfStreamReached()
 	// TODO: What should be put here as the event time? WatermarkMaxValue kind of makes sense. But on the other hand, if this is then i.e. StreamJoin'ed with something then it would make everything MaxValue. But only if this is Batch. If it's grouping by event time then the event times will be correct.
-	if err := g.trigger(ProduceFromExecutionContext(ctx), aggregates, previouslySentValues, trigger, WatermarkMaxValue, produce); err != nil {
+	if err := g.trigger(ProduceFromExecutionContext(ctx), state, trigger, WatermarkMaxValue, produce); err != nil {
 		return fmt.Errorf("couldn't trigger keys on end of stream: %w", err)
 	}
 
 	return nil
 }
 
-func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, previouslySentValues *btree.BTree, trigger Trigger, curEventTime time.Time, produce ProduceFn) error {
-	toTrigger := trigger.Poll()
+// runWithProcessingTimeTrigger runs the source in a separate goroutine,
+// so that keys can be triggered when the trigger's deadlines pass, even if no records arrive in the meantime.
+func (g *CustomTriggerGroupBy) runWithProcessingTimeTrigger(ctx ExecutionContext, trigger ProcessingTimeTrigger, produce ProduceFn, metaSend MetaSendFn, onDeadline func() error) error {
+	type chanMessage struct {
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		err             error
+	}
+
+	// The source goroutine stops sending once this function returns.
+	runCtx, cancel := context.WithCancel(ctx.Context)
+	defer cancel()
+	ctx = ExecutionContext{Context: runCtx, VariableContext: ctx.VariableContext}
+
+	messages := make(chan chanMessage, 10000)
+	send := func(msg chanMessage) error {
+		select {
+		case messages <- msg:
+			return nil
+		case <-runCtx.Done():
+			return runCtx.Err()
+		}
+	}
+
+	go func() {
+		defer close(messages)
+
+		if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			return send(chanMessage{record: record})
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			return send(chanMessage{metadata: true, metadataMessage: msg})
+		}); err != nil && runCtx.Err() == nil {
+			send(chanMessage{err: err})
+		}
+	}()
 
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		var deadlineChan <-chan time.Time
+		if deadline, ok := trigger.NextDeadline(); ok {
+			timer.Reset(time.Until(deadline))
+			deadlineChan = timer.C
+		}
+
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if msg.err != nil {
+				return msg.err
+			}
+			if msg.metadata {
+				if err := metaSend(ProduceFromExecutionContext(ctx), msg.metadataMessage); err != nil {
+					return err
+				}
+			} else if err := produce(ProduceFromExecutionContext(ctx), msg.record); err != nil {
+				return err
+			}
+		case <-deadlineChan:
+			if err := onDeadline(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, state *customTriggerGroupByState, trigger Trigger, curEventTime time.Time, produce ProduceFn) error {
+	return g.triggerKeys(produceCtx, state, trigger.Poll(), curEventTime, false, produce)
+}
+
+// triggerKeys sends the current values of the keys, retracting the previously sent ones.
+// If onlyChanged is set, keys with unchanged values are skipped.
+func (g *CustomTriggerGroupBy) triggerKeys(produceCtx ProduceContext, state *customTriggerGroupByState, toTrigger []GroupKey, curEventTime time.Time, onlyChanged bool, produce ProduceFn) error {
 	for _, key := range toTrigger {
 		// Get values and produce, retracting previous values.
 		newValueEventTime := curEventTime
@@ -171,14 +338,11 @@ func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, pr
 		{
 			// Get new record to send
 
-			item := aggregates.Get(key)
-			if item != nil {
-				itemTyped, ok := item.(*aggregatesItem)
-				if !ok {
-					// TODO: Check performance cost of those panics.
-					panic(fmt.Sprintf("invalid aggregates item: %v", item))
-				}
-
+			itemTyped, err := state.getAggregates(key, g.aggregatePrototypes)
+			if err != nil {
+				return err
+			}
+			if itemTyped != nil {
 				outputValues = make([]octosql.Value, len(key)+len(g.aggregateExprs))
 				copy(outputValues, key)
 
@@ -196,18 +360,27 @@ func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, pr
 				}
 			}
 		}
+		if onlyChanged {
+			previousValues, ok, err := state.getPreviouslySentValues(key)
+			if err != nil {
+				return err
+			}
+			if !ok && outputValues == nil {
+				continue
+			}
+			if ok && outputValues != nil && valueSlicesEqual(previousValues, outputValues) {
+				continue
+			}
+		}
 		{
 			// Send possible retraction
 
-			item := previouslySentValues.Delete(key)
-			if item != nil {
-				itemTyped, ok := item.(*previouslySentValuesItem)
-				if !ok {
-					// TODO: Check performance cost of those panics.
-					panic(fmt.Sprintf("invalid previously sent item: %v", item))
-				}
-
-				if err := produce(produceCtx, NewRecord(itemTyped.Values, true, newValueEventTime)); err != nil {
+			previousValues, ok, err := state.deletePreviouslySentValues(key)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := produce(produceCtx, NewRecord(previousValues, true, newValueEventTime)); err != nil {
 					return fmt.Errorf("couldn't produce: %w", err)
 				}
 			}
@@ -220,14 +393,171 @@ func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, pr
 					return fmt.Errorf("couldn't produce: %w", err)
 				}
 
-				previouslySentValues.ReplaceOrInsert(&previouslySentValuesItem{
+				if err := state.setPreviouslySentValues(&previouslySentValuesItem{
 					GroupKey:  key,
 					Values:    outputValues,
 					EventTime: newValueEventTime,
-				})
+				}); err != nil {
+					return err
+				}
 			}
 		}
 	}
 
 	return nil
 }
+
+// customTriggerGroupByState holds the aggregates and previously sent values of the keys.
+// Once the keys in memory take up half of the memory budget, the aggregate inputs and sent values of keys not in memory get spilled to disk.
+// The aggregates of a spilled key are computed from its inputs whenever it gets triggered.
+type customTriggerGroupByState struct {
+	aggregates           *btree.BTree
+	previouslySentValues *btree.BTree
+	memoryBudget         int
+	stateSize            int
+	// Once full, no keys are added to the in-memory state anymore.
+	full                             bool
+	spilledInputs, spilledSentValues *spill.Table
+}
+
+func newCustomTriggerGroupByState(memoryBudget int) *customTriggerGroupByState {
+	return &customTriggerGroupByState{
+		aggregates:           btree.New(BTreeDefaultDegree),
+		previouslySentValues: btree.New(BTreeDefaultDegree),
+		memoryBudget:         memoryBudget,
+		spilledInputs:        spill.NewTable(memoryBudget / 4),
+		spilledSentValues:    spill.NewTable(memoryBudget / 4),
+	}
+}
+
+// isFull checks if new keys have to be spilled.
+func (state *customTriggerGroupByState) isFull() bool {
+	if state.memoryBudget > 0 && state.stateSize >= state.memoryBudget/2 {
+		state.full = true
+	}
+	return state.full
+}
+
+func (state *customTriggerGroupByState) spillAggregateInputs(key GroupKey, retraction bool, aggregateInputs []octosql.Value) error {
+	count := 1
+	if retraction {
+		count = -1
+	}
+	if err := state.spilledInputs.Add(spill.Entry{Key: key, Values: aggregateInputs, Count: count}); err != nil {
+		return fmt.Errorf("couldn't spill aggregate inputs: %w", err)
+	}
+	return nil
+}
+
+// getAggregates returns the aggregates of the key, or nil if it has no records.
+func (state *customTriggerGroupByState) getAggregates(key GroupKey, aggregatePrototypes []func() Aggregate) (*aggregatesItem, error) {
+	if item := state.aggregates.Get(key); item != nil {
+		itemTyped, ok := item.(*aggregatesItem)
+		if !ok {
+			// TODO: Check performance cost of those panics.
+			panic(fmt.Sprintf("invalid aggregates item: %v", item))
+		}
+		return itemTyped, nil
+	}
+	if !state.full {
+		return nil, nil
+	}
+
+	entries, err := state.spilledInputs.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read spilled aggregate inputs: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	itemTyped := newAggregatesItem(key, aggregatePrototypes)
+	for i := range entries {
+		itemTyped.add(entries[i].Count < 0, entries[i].Values)
+	}
+	if itemTyped.OverallRecordCount == 0 {
+		if err := state.spilledInputs.Delete(key); err != nil {
+			return nil, fmt.Errorf("couldn't delete spilled aggregate inputs: %w", err)
+		}
+		return nil, nil
+	}
+	return itemTyped, nil
+}
+
+func (state *customTriggerGroupByState) deleteAggregates(key GroupKey) {
+	if item := state.aggregates.Delete(key); item != nil {
+		state.stateSize -= spill.Size(key) + len(item.(*aggregatesItem).Aggregates)*aggregateStateSize
+	}
+}
+
+func (state *customTriggerGroupByState) getPreviouslySentValues(key GroupKey) ([]octosql.Value, bool, error) {
+	if item := state.previouslySentValues.Get(key); item != nil {
+		return item.(*previouslySentValuesItem).Values, true, nil
+	}
+	if !state.full {
+		return nil, false, nil
+	}
+	entries, err := state.spilledSentValues.Get(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("couldn't read spilled sent values: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, false, nil
+	}
+	return entries[len(entries)-1].Values, true, nil
+}
+
+func (state *customTriggerGroupByState) deletePreviouslySentValues(key GroupKey) ([]octosql.Value, bool, error) {
+	if item := state.previouslySentValues.Delete(key); item != nil {
+		itemTyped, ok := item.(*previouslySentValuesItem)
+		if !ok {
+			// TODO: Check performance cost of those panics.
+			panic(fmt.Sprintf("invalid previously sent item: %v", item))
+		}
+		state.stateSize -= spill.Size(itemTyped.Values)
+		return itemTyped.Values, true, nil
+	}
+	values, ok, err := state.getPreviouslySentValues(key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	if err := state.spilledSentValues.Delete(key); err != nil {
+		return nil, false, fmt.Errorf("couldn't delete spilled sent values: %w", err)
+	}
+	return values, true, nil
+}
+
+// setPreviouslySentValues keeps the sent values in memory if the aggregates of the key are in memory, and spills them otherwise.
+func (state *customTriggerGroupByState) setPreviouslySentValues(item *previouslySentValuesItem) error {
+	if state.aggregates.Get(item.GroupKey) != nil {
+		state.previouslySentValues.ReplaceOrInsert(item)
+		state.stateSize += spill.Size(item.Values)
+		return nil
+	}
+	if err := state.spilledSentValues.Add(spill.Entry{Key: item.GroupKey, Values: item.Values, Count: 1}); err != nil {
+		return fmt.Errorf("couldn't spill sent values: %w", err)
+	}
+	return nil
+}
+
+// expire drops the whole state of the key.
+func (state *customTriggerGroupByState) expire(key GroupKey) error {
+	state.deleteAggregates(key)
+	if item := state.previouslySentValues.Delete(key); item != nil {
+		state.stateSize -= spill.Size(item.(*previouslySentValuesItem).Values)
+	}
+	if !state.full {
+		return nil
+	}
+	if err := state.spilledInputs.Delete(key); err != nil {
+		return fmt.Errorf("couldn't delete spilled aggregate inputs: %w", err)
+	}
+	if err := state.spilledSentValues.Delete(key); err != nil {
+		return fmt.Errorf("couldn't delete spilled sent values: %w", err)
+	}
+	return nil
+}
+
+func (state *customTriggerGroupByState) close() {
+	state.spilledInputs.Close()
+	state.spilledSentValues.Close()
+}
@@ -6,16 +6,21 @@ import (
 	"github.com/tidwall/btree"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
 	"github.com/cube2222/octosql/octosql"
 )
 
 type Distinct struct {
 	source Node
+	// Once the record counts take up half of the memory budget, the counts of records not in memory get spilled to disk,
+	// and are looked up there. Disabled if zero.
+	memoryBudget int
 }
 
-func NewDistinct(source Node) *Distinct {
+func NewDistinct(source Node, memoryBudget int) *Distinct {
 	return &Distinct{
-		source: source,
+		source:       source,
+		memoryBudget: memoryBudget,
 	}
 }
 
@@ -36,11 +41,23 @@ func (o *Distinct) Run(execCtx ExecutionContext, produce ProduceFn, metaSend Met
 	}, btree.Options{
 		NoLocks: true,
 	})
+	stateSize := 0
+	// Once full, no records are added to the in-memory counts anymore.
+	full := false
+	spilled := spill.NewTable(o.memoryBudget / 2)
+	defer spilled.Close()
+
 	o.source.Run(
 		execCtx,
 		func(ctx ProduceContext, record Record) error {
 			item, ok := recordCounts.Get(&distinctItem{Values: record.Values})
 			if !ok {
+				if o.memoryBudget > 0 && stateSize >= o.memoryBudget/2 {
+					full = true
+				}
+				if full {
+					return o.receiveSpilledRecord(ctx, produce, spilled, record)
+				}
 				item = &distinctItem{
 					Values: record.Values,
 					Count:  0,
@@ -58,12 +75,15 @@ func (o *Distinct) Run(execCtx ExecutionContext, produce ProduceFn, metaSend Met
 						return fmt.Errorf("couldn't produce new record: %w", err)
 					}
 					recordCounts.Set(item)
+					stateSize += spill.Size(item.Values)
 				}
 			} else {
 				if err := produce(ctx, record); err != nil {
 					return fmt.Errorf("couldn't retract record record: %w", err)
 				}
-				recordCounts.Delete(item)
+				if _, ok := recordCounts.Delete(item); ok {
+					stateSize -= spill.Size(item.Values)
+				}
 			}
 			return nil
 		},
@@ -74,3 +94,47 @@ func (o *Distinct) Run(execCtx ExecutionContext, produce ProduceFn, metaSend Met
 
 	return nil
 }
+
+// receiveSpilledRecord updates the count of a record which isn't kept in memory.
+// The spilled entries of a record are replaced by a single one with its current count.
+func (o *Distinct) receiveSpilledRecord(ctx ProduceContext, produce ProduceFn, spilled *spill.Table, record Record) error {
+	entries, err := spilled.Get(record.Values)
+	if err != nil {
+		return fmt.Errorf("couldn't read spilled record count: %w", err)
+	}
+	count := 0
+	for i := range entries {
+		count += entries[i].Count
+	}
+
+	oldCount := count
+	if !record.Retraction {
+		count++
+	} else {
+		count--
+	}
+	if count > 0 {
+		// New record.
+		if !record.Retraction && count == 1 {
+			if err := produce(ctx, record); err != nil {
+				return fmt.Errorf("couldn't produce new record: %w", err)
+			}
+		}
+	} else {
+		if err := produce(ctx, record); err != nil {
+			return fmt.Errorf("couldn't retract record record: %w", err)
+		}
+	}
+
+	if oldCount != 0 {
+		if err := spilled.Delete(record.Values); err != nil {
+			return fmt.Errorf("couldn't spill record count: %w", err)
+		}
+	}
+	if count > 0 {
+		if err := spilled.Add(spill.Entry{Key: record.Values, Count: count}); err != nil {
+			return fmt.Errorf("couldn't spill record count: %w", err)
+		}
+	}
+	return nil
+}
@@ -2,20 +2,23 @@ package nodes
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/cube2222/octosql/execution"
 )
 
 type EventTimeBuffer struct {
-	source Node
+	source      Node
+	lateRecords *LateRecords
 }
 
-func NewEventTimeBuffer(source Node) *EventTimeBuffer {
-	return &EventTimeBuffer{source: source}
+func NewEventTimeBuffer(source Node, lateRecords *LateRecords) *EventTimeBuffer {
+	return &EventTimeBuffer{source: source, lateRecords: lateRecords}
 }
 
 func (e *EventTimeBuffer) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
-	records := NewRecordEventTimeBuffer()
+	records := NewRecordEventTimeBuffer(e.lateRecords)
+	var watermark time.Time
 
 	if err := e.source.Run(
 		ctx,
@@ -25,11 +28,20 @@ func (e *EventTimeBuffer) Run(ctx ExecutionContext, produce ProduceFn, metaSend
 				// There won't be any record with an event time less than zero.
 				return produce(ctx, record)
 			}
-			records.AddRecord(record)
+			if err := records.AddRecord(record); err != nil {
+				return fmt.Errorf("couldn't buffer record: %w", err)
+			}
+			if !record.EventTime.After(watermark) {
+				// The record is late, there's no point in waiting for the next watermark with it.
+				if err := records.Emit(watermark, ProduceFnApplyContext(produce, ctx)); err != nil {
+					return fmt.Errorf("couldn't emit late record: %w", err)
+				}
+			}
 			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error {
 			if msg.Type == MetadataMessageTypeWatermark {
+				watermark = msg.Watermark
 				if err := records.Emit(msg.Watermark, ProduceFnApplyContext(produce, ctx)); err != nil {
 					return fmt.Errorf("couldn't emit records up to watermark: %w", err)
 				}
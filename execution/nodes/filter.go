@@ -39,3 +39,33 @@ func (m *Filter) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendF
 	}
 	return nil
 }
+
+func (m *Filter) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
+	// The records passing the filter are produced as they are, so their values still belong to the source.
+	out := &RecordBatch{}
+	if err := RunBatch(m.source, ctx, func(produceCtx ProduceContext, batch *RecordBatch) error {
+		out.Reset()
+		for _, record := range batch.Records {
+			ctx := ctx.WithRecord(record)
+
+			ok, err := m.predicate.Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate condition: %w", err)
+			}
+			if ok.TypeID == octosql.TypeIDBoolean && ok.Boolean {
+				out.Add(record)
+			}
+		}
+		if out.Len() == 0 {
+			return nil
+		}
+		if err := produce(produceCtx, out); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+
+		return nil
+	}, metaSend); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+	return nil
+}
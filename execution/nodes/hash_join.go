@@ -0,0 +1,134 @@
+package nodes
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// HashJoin is an inner join of inputs with no retractions and no time field.
+// Both inputs are read at the same time, joining each record with the ones of the other side received so far.
+// Once one of the inputs finishes, its hash table is complete, so it becomes the build side,
+// the hash table of the other side gets dropped, and the rest of the other side only probes the build side.
+// This way the smaller input is usually the one kept in memory, and an unbounded input can still be joined with a bounded one.
+type HashJoin struct {
+	left, right                 Node
+	keyExprsLeft, keyExprsRight []Expression
+	watermarkIdleTimeout        time.Duration
+}
+
+func NewHashJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, watermarkIdleTimeout time.Duration) *HashJoin {
+	return &HashJoin{
+		left:                 left,
+		right:                right,
+		keyExprsLeft:         keyExprsLeft,
+		keyExprsRight:        keyExprsRight,
+		watermarkIdleTimeout: watermarkIdleTimeout,
+	}
+}
+
+// hashJoinTable holds the records of one side of the join, by the hash of their key.
+type hashJoinTable map[uint64][]*hashJoinEntry
+
+type hashJoinEntry struct {
+	key     GroupKey
+	records [][]octosql.Value
+}
+
+func (t hashJoinTable) get(hash uint64, key GroupKey) *hashJoinEntry {
+	for _, entry := range t[hash] {
+		if !CompareValueSlices(entry.key, key) && !CompareValueSlices(key, entry.key) {
+			return entry
+		}
+	}
+	return nil
+}
+
+func (t hashJoinTable) add(hash uint64, key GroupKey, values []octosql.Value) {
+	if entry := t.get(hash, key); entry != nil {
+		entry.records = append(entry.records, values)
+		return
+	}
+	t[hash] = append(t[hash], &hashJoinEntry{key: key, records: [][]octosql.Value{values}})
+}
+
+func (s *HashJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	leftRecords := make(hashJoinTable)
+	rightRecords := make(hashJoinTable)
+
+	hash := fnv.New64a()
+	receiveRecord := func(record Record, amLeft bool) error {
+		if record.Retraction {
+			return fmt.Errorf("hash join doesn't support retractions")
+		}
+
+		keyExprs, myRecords, otherRecords := s.keyExprsLeft, leftRecords, rightRecords
+		if !amLeft {
+			keyExprs, myRecords, otherRecords = s.keyExprsRight, rightRecords, leftRecords
+		}
+
+		recordCtx := ctx.WithRecord(record)
+		key := make(GroupKey, len(keyExprs))
+		hash.Reset()
+		for i, expr := range keyExprs {
+			value, err := expr.Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d hash join key expression: %w", i, err)
+			}
+			key[i] = value
+			value.Hash(hash)
+		}
+		keyHash := hash.Sum64()
+
+		// The table of this side is only needed until the other side finishes.
+		if myRecords != nil {
+			myRecords.add(keyHash, key, record.Values)
+		}
+
+		entry := otherRecords.get(keyHash, key)
+		if entry == nil {
+			return nil
+		}
+		for _, otherValues := range entry.records {
+			outputValues := make([]octosql.Value, len(record.Values)+len(otherValues))
+			if amLeft {
+				copy(outputValues, record.Values)
+				copy(outputValues[len(record.Values):], otherValues)
+			} else {
+				copy(outputValues, otherValues)
+				copy(outputValues[len(otherValues):], record.Values)
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, false, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		return nil
+	}
+
+	return runTwoInputs(ctx, s.left, s.right, "hash join", s.watermarkIdleTimeout, metaSend, twoInputHandlers{
+		records: func(input int, records []Record) error {
+			for _, record := range records {
+				if err := receiveRecord(record, input == 0); err != nil {
+					if input == 0 {
+						return fmt.Errorf("couldn't process record from left: %w", err)
+					}
+					return fmt.Errorf("couldn't process record from right: %w", err)
+				}
+			}
+			return nil
+		},
+		finished: func(input int) error {
+			// The finished side is complete, so it's the build side now.
+			if input == 0 {
+				rightRecords = nil
+			} else {
+				leftRecords = nil
+			}
+			return nil
+		},
+	})
+}
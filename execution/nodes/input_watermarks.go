@@ -0,0 +1,132 @@
+package nodes
+
+import (
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+// inputWatermarks tracks the watermarks of the inputs of nodes with multiple inputs.
+type inputWatermarks struct {
+	idleTimeout time.Duration
+	watermarks  []time.Time
+	lastActive  []time.Time
+	idle        []bool
+	timer       *time.Timer
+}
+
+// newInputWatermarks tracks the watermarks of the given number of inputs.
+// The idle timeout is the watermark idle timeout of the node, disabled if zero.
+// Inputs which haven't sent anything for that long are considered idle,
+// and don't hold back the minimum watermark until they send something again.
+// Records which an input sends after being idle may be late then.
+func newInputWatermarks(inputCount int, idleTimeout time.Duration) *inputWatermarks {
+	w := &inputWatermarks{
+		idleTimeout: idleTimeout,
+		watermarks:  make([]time.Time, inputCount),
+		lastActive:  make([]time.Time, inputCount),
+		idle:        make([]bool, inputCount),
+	}
+	if idleTimeout > 0 {
+		now := time.Now()
+		for i := range w.lastActive {
+			w.lastActive[i] = now
+		}
+		w.timer = time.NewTimer(idleTimeout)
+	}
+	return w
+}
+
+// received marks the input as active.
+func (w *inputWatermarks) received(input int) {
+	if w.idleTimeout > 0 {
+		w.lastActive[input] = time.Now()
+		w.idle[input] = false
+	}
+}
+
+func (w *inputWatermarks) setWatermark(input int, watermark time.Time) {
+	w.watermarks[input] = watermark
+}
+
+func (w *inputWatermarks) watermark(input int) time.Time {
+	return w.watermarks[input]
+}
+
+// finished sets the watermark of a finished input to the maximum watermark, so it doesn't hold back the other ones.
+func (w *inputWatermarks) finished(input int) {
+	w.watermarks[input] = WatermarkMaxValue
+}
+
+// min returns the minimum watermark of the active inputs.
+// If all unfinished inputs are idle, none of them holds back the others, so the maximum of their watermarks is returned.
+func (w *inputWatermarks) min() time.Time {
+	min := WatermarkMaxValue
+	var maxIdle time.Time
+	found, foundIdle := false, false
+	for i := range w.watermarks {
+		if w.watermarks[i] == WatermarkMaxValue {
+			continue
+		}
+		if w.idle[i] {
+			if w.watermarks[i].After(maxIdle) {
+				maxIdle = w.watermarks[i]
+			}
+			foundIdle = true
+			continue
+		}
+		if w.watermarks[i].Before(min) {
+			min = w.watermarks[i]
+		}
+		found = true
+	}
+	if !found && foundIdle {
+		return maxIdle
+	}
+	return min
+}
+
+// idleCheck returns a channel which fires when the next input may become idle, or nil if there's no such input.
+func (w *inputWatermarks) idleCheck() <-chan time.Time {
+	if w.idleTimeout == 0 {
+		return nil
+	}
+	var next time.Time
+	found := false
+	for i := range w.lastActive {
+		if w.idle[i] || w.watermarks[i] == WatermarkMaxValue {
+			continue
+		}
+		if deadline := w.lastActive[i].Add(w.idleTimeout); !found || deadline.Before(next) {
+			next = deadline
+			found = true
+		}
+	}
+	if !w.timer.Stop() {
+		select {
+		case <-w.timer.C:
+		default:
+		}
+	}
+	if !found {
+		return nil
+	}
+	w.timer.Reset(time.Until(next))
+	return w.timer.C
+}
+
+// markIdle marks the inputs which haven't sent anything for the idle timeout as idle.
+func (w *inputWatermarks) markIdle() {
+	now := time.Now()
+	for i := range w.lastActive {
+		if w.watermarks[i] != WatermarkMaxValue && !now.Before(w.lastActive[i].Add(w.idleTimeout)) {
+			w.idle[i] = true
+		}
+	}
+}
+
+func (w *inputWatermarks) stop() {
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
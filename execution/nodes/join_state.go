@@ -0,0 +1,254 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	tbtree "github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// joinState holds the records of one side of a join, indexed by their key.
+// Once the records in memory take up half of the memory budget, the records of keys not in memory get spilled to disk,
+// and are read back from there when the key is looked up.
+//
+// Each spilled entry has the record values followed by one more value:
+// - If the count is positive, the event time of the record, which has been added count times.
+// - If the count is negative, it's ignored, and that many event times of the record have been removed.
+// - If the count is zero, the change of the count of matching records of the record.
+type joinState struct {
+	records      *tbtree.Generic[*streamJoinItem]
+	memoryBudget int
+	stateSize    int
+	// Once full, no keys are added to the in-memory records anymore.
+	full    bool
+	spilled *spill.Table
+}
+
+func newJoinState(memoryBudget int) *joinState {
+	return &joinState{
+		records: tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
+			return CompareValueSlices(a.GroupKey, b.GroupKey)
+		}, tbtree.Options{
+			NoLocks: true,
+		}),
+		memoryBudget: memoryBudget,
+		spilled:      spill.NewTable(memoryBudget / 2),
+	}
+}
+
+func newStreamJoinItem(key GroupKey) *streamJoinItem {
+	return &streamJoinItem{GroupKey: key, values: tbtree.NewGenericOptions(func(a, b *streamJoinSubitem) bool {
+		return CompareValueSlices(a.GroupKey, b.GroupKey)
+	}, tbtree.Options{NoLocks: true})}
+}
+
+// joinStateUpdate describes how an update changed the records of a key.
+type joinStateUpdate struct {
+	// The key had no records before the update, or has none after it.
+	firstForKey, lastForKey bool
+	// The subitem of the record values, which has been added or removed by the update, if set.
+	subitem                    *streamJoinSubitem
+	newSubitem, removedSubitem bool
+}
+
+// get returns the records of the key.
+// Changes to the returned item aren't kept for spilled keys.
+func (j *joinState) get(key GroupKey) (*streamJoinItem, bool, error) {
+	if itemTyped, ok := j.records.Get(&streamJoinItem{GroupKey: key}); ok {
+		return itemTyped, true, nil
+	}
+	if !j.full {
+		return nil, false, nil
+	}
+	return j.getSpilled(key)
+}
+
+func (j *joinState) getSpilled(key GroupKey) (*streamJoinItem, bool, error) {
+	entries, err := j.spilled.Get(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("couldn't read spilled join records: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, false, nil
+	}
+
+	itemTyped := newStreamJoinItem(key)
+	for _, entry := range entries {
+		values, last := entry.Values[:len(entry.Values)-1], entry.Values[len(entry.Values)-1]
+		subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: values})
+		switch {
+		case entry.Count > 0:
+			if !ok {
+				subitemTyped = &streamJoinSubitem{GroupKey: values}
+				itemTyped.values.Set(subitemTyped)
+			}
+			for i := 0; i < entry.Count; i++ {
+				subitemTyped.EventTimes = append(subitemTyped.EventTimes, last.Time)
+			}
+		case !ok:
+			continue
+		case entry.Count < 0:
+			if -entry.Count < len(subitemTyped.EventTimes) {
+				subitemTyped.EventTimes = subitemTyped.EventTimes[-entry.Count:]
+			} else {
+				subitemTyped.EventTimes = nil
+			}
+		default:
+			subitemTyped.Matches += last.Int
+		}
+		if len(subitemTyped.EventTimes) == 0 {
+			itemTyped.values.Delete(subitemTyped)
+		}
+	}
+	if itemTyped.values.Len() == 0 {
+		return nil, false, nil
+	}
+	return itemTyped, true, nil
+}
+
+// update adds the record to the records of the key, or removes it if it's a retraction.
+func (j *joinState) update(key GroupKey, record Record) (joinStateUpdate, error) {
+	itemTyped, ok := j.records.Get(&streamJoinItem{GroupKey: key})
+	inMemory := ok
+	if !ok {
+		if j.memoryBudget > 0 && j.stateSize >= j.memoryBudget/2 {
+			j.full = true
+		}
+		if j.full {
+			var err error
+			if itemTyped, ok, err = j.getSpilled(key); err != nil {
+				return joinStateUpdate{}, err
+			}
+			if !ok {
+				itemTyped = newStreamJoinItem(key)
+			}
+		} else {
+			itemTyped = newStreamJoinItem(key)
+			j.records.Set(itemTyped)
+			j.stateSize += spill.Size(key)
+			inMemory = true
+		}
+	}
+
+	update := joinStateUpdate{firstForKey: !ok}
+	subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
+	if !ok {
+		subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
+		itemTyped.values.Set(subitemTyped)
+		update.newSubitem = true
+	}
+	if !record.Retraction {
+		subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
+	} else {
+		// TODO: This should delete the matching event time.
+		subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
+	}
+	if len(subitemTyped.EventTimes) == 0 {
+		itemTyped.values.Delete(subitemTyped)
+		update.removedSubitem = true
+	}
+	update.subitem = subitemTyped
+	update.lastForKey = itemTyped.values.Len() == 0
+
+	if inMemory {
+		if !record.Retraction {
+			j.stateSize += spill.Size(record.Values)
+		} else {
+			j.stateSize -= spill.Size(record.Values)
+		}
+		if update.lastForKey {
+			j.records.Delete(itemTyped)
+			j.stateSize -= spill.Size(key)
+		}
+		return update, nil
+	}
+
+	if update.lastForKey {
+		if err := j.spilled.Delete(key); err != nil {
+			return joinStateUpdate{}, fmt.Errorf("couldn't spill join records: %w", err)
+		}
+		return update, nil
+	}
+	count := 1
+	if record.Retraction {
+		count = -1
+	}
+	if err := j.spilled.Add(spill.Entry{Key: key, Values: appendValue(record.Values, octosql.NewTime(record.EventTime)), Count: count}); err != nil {
+		return joinStateUpdate{}, fmt.Errorf("couldn't spill join records: %w", err)
+	}
+	return update, nil
+}
+
+// addMatches changes the count of matching records of a subitem of the key.
+func (j *joinState) addMatches(key GroupKey, subitem *streamJoinSubitem, diff int) error {
+	subitem.Matches += diff
+	if _, ok := j.records.Get(&streamJoinItem{GroupKey: key}); ok {
+		return nil
+	}
+	if err := j.spilled.Add(spill.Entry{Key: key, Values: appendValue(subitem.GroupKey, octosql.NewInt(diff)), Count: 0}); err != nil {
+		return fmt.Errorf("couldn't spill join record matches: %w", err)
+	}
+	return nil
+}
+
+// remove removes the records with the given values from the records of the key, returning their subitem.
+func (j *joinState) remove(key, values GroupKey) (*streamJoinSubitem, bool, error) {
+	if itemTyped, ok := j.records.Get(&streamJoinItem{GroupKey: key}); ok {
+		subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: values})
+		if !ok {
+			return nil, false, nil
+		}
+		itemTyped.values.Delete(subitemTyped)
+		j.stateSize -= spill.Size(values) * len(subitemTyped.EventTimes)
+		if itemTyped.values.Len() == 0 {
+			j.records.Delete(itemTyped)
+			j.stateSize -= spill.Size(key)
+		}
+		return subitemTyped, true, nil
+	}
+	if !j.full {
+		return nil, false, nil
+	}
+
+	itemTyped, ok, err := j.getSpilled(key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: values})
+	if !ok {
+		return nil, false, nil
+	}
+	if itemTyped.values.Len() == 1 {
+		if err := j.spilled.Delete(key); err != nil {
+			return nil, false, fmt.Errorf("couldn't spill join records: %w", err)
+		}
+	} else if err := j.spilled.Add(spill.Entry{Key: key, Values: appendValue(values, octosql.NewTime(time.Time{})), Count: -len(subitemTyped.EventTimes)}); err != nil {
+		return nil, false, fmt.Errorf("couldn't spill join records: %w", err)
+	}
+	return subitemTyped, true, nil
+}
+
+// contains checks if there are records with the given values for the key.
+func (j *joinState) contains(key, values GroupKey) (bool, error) {
+	itemTyped, ok, err := j.get(key)
+	if err != nil || !ok {
+		return false, err
+	}
+	_, ok = itemTyped.values.Get(&streamJoinSubitem{GroupKey: values})
+	return ok, nil
+}
+
+func (j *joinState) close() {
+	j.spilled.Close()
+}
+
+func appendValue(values []octosql.Value, value octosql.Value) []octosql.Value {
+	out := make([]octosql.Value, len(values)+1)
+	copy(out, values)
+	out[len(values)] = value
+	return out
+}
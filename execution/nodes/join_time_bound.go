@@ -0,0 +1,141 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	tbtree "github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// JoinTimeBound limits the difference between the time field of the left record and the time field of the right record of a join.
+// A nil limit means the difference is unbounded in that direction.
+type JoinTimeBound struct {
+	LeftTimeFieldIndex, RightTimeFieldIndex int
+	Lower, Upper                            *JoinTimeBoundLimit
+}
+
+type JoinTimeBoundLimit struct {
+	Difference time.Duration
+	Inclusive  bool
+}
+
+func (bound *JoinTimeBound) Matches(leftValues, rightValues []octosql.Value) bool {
+	difference := leftValues[bound.LeftTimeFieldIndex].Time.Sub(rightValues[bound.RightTimeFieldIndex].Time)
+	if bound.Lower != nil {
+		if difference < bound.Lower.Difference || (difference == bound.Lower.Difference && !bound.Lower.Inclusive) {
+			return false
+		}
+	}
+	if bound.Upper != nil {
+		if difference > bound.Upper.Difference || (difference == bound.Upper.Difference && !bound.Upper.Inclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func timeBoundMatches(bound *JoinTimeBound, amLeft bool, myValues, otherValues []octosql.Value) bool {
+	if amLeft {
+		return bound.Matches(myValues, otherValues)
+	}
+	return bound.Matches(otherValues, myValues)
+}
+
+// evictionThreshold returns the time up to which records of the given side can't be joined with any future records of the other side,
+// assuming future records have an event time after the watermark.
+// It returns false if the records of that side can't ever be evicted.
+func (bound *JoinTimeBound) evictionThreshold(left bool, watermark time.Time) (time.Time, bool) {
+	if left {
+		// A left record can only be joined with right records having a time of at most its own time - lower.
+		if bound.Lower == nil {
+			return time.Time{}, false
+		}
+		return watermark.Add(bound.Lower.Difference), true
+	}
+	// A right record can only be joined with left records having a time of at most its own time + upper.
+	if bound.Upper == nil {
+		return time.Time{}, false
+	}
+	return watermark.Add(-bound.Upper.Difference), true
+}
+
+// maxEvictionDelay returns how far behind the watermark the time of records being evicted may be.
+func (bound *JoinTimeBound) maxEvictionDelay(left bool) time.Duration {
+	var delay time.Duration
+	if left && bound.Lower != nil && bound.Lower.Difference < 0 {
+		delay = -bound.Lower.Difference
+	}
+	if !left && bound.Upper != nil && bound.Upper.Difference > 0 {
+		delay = bound.Upper.Difference
+	}
+	return delay
+}
+
+type joinEvictionQueueItem struct {
+	Time time.Time
+	// Key and values of the join record.
+	Key, Values GroupKey
+}
+
+// joinEvictionQueue orders the records of one side of a join by their time field,
+// so that they can be evicted from the join state once they can't be joined anymore.
+type joinEvictionQueue struct {
+	timeFieldIndex int
+	items          *tbtree.Generic[*joinEvictionQueueItem]
+}
+
+func newJoinEvictionQueue(timeFieldIndex int) *joinEvictionQueue {
+	return &joinEvictionQueue{
+		timeFieldIndex: timeFieldIndex,
+		items: tbtree.NewGenericOptions(func(a, b *joinEvictionQueueItem) bool {
+			if !a.Time.Equal(b.Time) {
+				return a.Time.Before(b.Time)
+			}
+			if CompareValueSlices(a.Key, b.Key) {
+				return true
+			} else if CompareValueSlices(b.Key, a.Key) {
+				return false
+			}
+			return CompareValueSlices(a.Values, b.Values)
+		}, tbtree.Options{
+			NoLocks: true,
+		}),
+	}
+}
+
+func (q *joinEvictionQueue) add(key, values GroupKey) {
+	q.items.Set(&joinEvictionQueueItem{Time: values[q.timeFieldIndex].Time, Key: key, Values: values})
+}
+
+func (q *joinEvictionQueue) remove(key, values GroupKey) {
+	q.items.Delete(&joinEvictionQueueItem{Time: values[q.timeFieldIndex].Time, Key: key, Values: values})
+}
+
+// evict removes the records with a time up to the threshold from the join state, calling onEvict for each of them.
+// If all is set, the threshold is ignored and all records get evicted.
+func (q *joinEvictionQueue) evict(state *joinState, threshold time.Time, all bool, onEvict func(subitem *streamJoinSubitem) error) error {
+	for {
+		item, ok := q.items.Min()
+		if !ok || (!all && item.Time.After(threshold)) {
+			return nil
+		}
+		q.items.Delete(item)
+
+		subitemTyped, ok, err := state.remove(item.Key, item.Values)
+		if err != nil {
+			return fmt.Errorf("couldn't evict record: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		if onEvict != nil {
+			if err := onEvict(subitemTyped); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,12 +13,14 @@ import (
 type Limit struct {
 	source Node
 	limit  Expression
+	offset *Expression
 }
 
-func NewLimit(source Node, limit Expression) *Limit {
+func NewLimit(source Node, limit Expression, offset *Expression) *Limit {
 	return &Limit{
 		source: source,
 		limit:  limit,
+		offset: offset,
 	}
 }
 
@@ -27,11 +29,30 @@ func (m *Limit) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn
 	if err != nil {
 		return fmt.Errorf("couldn't evaluate limit expression: %w", err)
 	}
+	if limit.Int == 0 {
+		return nil
+	}
+	offset := 0
+	if m.offset != nil {
+		value, err := (*m.offset).Evaluate(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate offset expression: %w", err)
+		}
+		if value.Int < 0 {
+			return fmt.Errorf("offset must be non-negative, got %d", value.Int)
+		}
+		offset = value.Int
+	}
 
 	limitNodeID := ulid.MustNew(ulid.Now(), rand.Reader).String()
 
+	skipped := 0
 	i := 0
 	if err := m.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		if skipped < offset {
+			skipped++
+			return nil
+		}
 		if err := produce(produceCtx, record); err != nil {
 			return fmt.Errorf("couldn't produce: %w", err)
 		}
@@ -1,50 +1,225 @@
 package nodes
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
 	"github.com/cube2222/octosql/octosql"
 )
 
 type LookupJoin struct {
 	source, joined Node
+	// correlatedFields are the indices of the source fields the joined stream uses.
+	correlatedFields []int
+	// concurrency is the maximum number of joined streams running at the same time.
+	// The output keeps the order of the source records either way.
+	concurrency int
+	// The outputs of the joined stream get cached by the values of the correlated fields, using up to cacheSize bytes.
+	// Cached outputs expire after cacheTTL, or never if it's zero. The cache is disabled if cacheSize is zero.
+	cacheSize int
+	cacheTTL  time.Duration
 }
 
-func NewLookupJoin(source, joined Node) *LookupJoin {
+func NewLookupJoin(source, joined Node, correlatedFields []int, concurrency int, cacheSize int, cacheTTL time.Duration) *LookupJoin {
 	return &LookupJoin{
-		source: source,
-		joined: joined,
+		source:           source,
+		joined:           joined,
+		correlatedFields: correlatedFields,
+		concurrency:      concurrency,
+		cacheSize:        cacheSize,
+		cacheTTL:         cacheTTL,
 	}
 }
 
+// lookupOutput is a record or metadata message sent by a joined stream.
+type lookupOutput struct {
+	metadata bool
+	record   Record
+	msg      MetadataMessage
+}
+
+// lookupResult holds the output of a single run of the joined stream, which may still be running.
+type lookupResult struct {
+	key     string
+	done    chan struct{}
+	outputs []lookupOutput
+	err     error
+}
+
+type lookupJob struct {
+	sourceRecord Record
+	result       *lookupResult
+}
+
+// lookupOutputOverhead is a rough estimate of the memory used by a cached output, excluding its values.
+const lookupOutputOverhead = 64
+
 func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
-	// TODO: Add parallelism here.
+	var cache *ristretto.Cache
+	if s.cacheSize > 0 {
+		var err error
+		cache, err = ristretto.NewCache(&ristretto.Config{
+			NumCounters: 1 << 16,
+			MaxCost:     int64(s.cacheSize),
+			BufferItems: 64,
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't initialize lookup join cache: %w", err)
+		}
+		defer cache.Close()
+	}
 
-	// TODO: Maybe this should be fully parallel with no ordering guarantees and maybe a parallelism limit (semaphore). This way it would kinda work when the joined stream has a time field.
-	if err := s.source.Run(ctx, func(produceCtx ProduceContext, sourceRecord Record) error {
-		ctx := ctx.WithRecord(sourceRecord)
+	runCtx, cancel := context.WithCancel(ctx.Context)
+	ctx = ExecutionContext{Context: runCtx, VariableContext: ctx.VariableContext}
+	var wg sync.WaitGroup
+	// The joined streams have to finish before the cache gets closed.
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	semaphore := make(chan struct{}, s.concurrency)
+
+	runJoined := func(sourceRecord Record, result *lookupResult) {
+		defer close(result.done)
 
-		if err := s.joined.Run(ctx, func(produceCtx ProduceContext, joinedRecord Record) error {
-			outputValues := make([]octosql.Value, len(sourceRecord.Values)+len(joinedRecord.Values))
+		if err := s.joined.Run(ctx.WithRecord(sourceRecord), func(produceCtx ProduceContext, joinedRecord Record) error {
+			result.outputs = append(result.outputs, lookupOutput{record: joinedRecord})
+			return nil
+		}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+			result.outputs = append(result.outputs, lookupOutput{metadata: true, msg: msg})
+			return nil
+		}); err != nil {
+			result.err = fmt.Errorf("couldn't run joined stream: %w", err)
+			return
+		}
+
+		if cache != nil {
+			cost := len(result.key)
+			for i := range result.outputs {
+				cost += lookupOutputOverhead + spill.Size(result.outputs[i].record.Values)
+			}
+			cache.SetWithTTL(result.key, result, int64(cost), s.cacheTTL)
+		}
+	}
+
+	// Jobs are kept in the order of their source records, results of the joined streams may be shared by multiple jobs.
+	var pending []lookupJob
+	running := make(map[string]*lookupResult)
+
+	emit := func(job lookupJob) error {
+		<-job.result.done
+		if running[job.result.key] == job.result {
+			delete(running, job.result.key)
+		}
+		if job.result.err != nil {
+			return job.result.err
+		}
+
+		for _, output := range job.result.outputs {
+			if output.metadata {
+				if err := metaSend(ProduceFromExecutionContext(ctx), output.msg); err != nil {
+					return fmt.Errorf("couldn't send metadata: %w", err)
+				}
+				continue
+			}
+			joinedRecord := output.record
+			outputValues := make([]octosql.Value, len(job.sourceRecord.Values)+len(joinedRecord.Values))
 
-			copy(outputValues, sourceRecord.Values)
-			copy(outputValues[len(sourceRecord.Values):], joinedRecord.Values)
+			copy(outputValues, job.sourceRecord.Values)
+			copy(outputValues[len(job.sourceRecord.Values):], joinedRecord.Values)
 
-			retraction := (sourceRecord.Retraction || joinedRecord.Retraction) && !(sourceRecord.Retraction && joinedRecord.Retraction)
+			retraction := (job.sourceRecord.Retraction || joinedRecord.Retraction) && !(job.sourceRecord.Retraction && joinedRecord.Retraction)
 
-			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, retraction, sourceRecord.EventTime)); err != nil {
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, retraction, job.sourceRecord.EventTime)); err != nil {
 				return fmt.Errorf("couldn't produce: %w", err)
 			}
+		}
+		return nil
+	}
+	isDone := func(job lookupJob) bool {
+		select {
+		case <-job.result.done:
+			return true
+		default:
+			return false
+		}
+	}
+	emitAll := func() error {
+		for len(pending) > 0 {
+			if err := emit(pending[0]); err != nil {
+				return err
+			}
+			pending = pending[1:]
+		}
+		return nil
+	}
 
-			return nil
-		}, metaSend); err != nil {
-			return fmt.Errorf("couldn't run joined stream: %w", err)
+	if err := s.source.Run(ctx, func(produceCtx ProduceContext, sourceRecord Record) error {
+		var result *lookupResult
+		if cache != nil {
+			correlatedValues := make([]octosql.Value, len(s.correlatedFields))
+			for i, fieldIndex := range s.correlatedFields {
+				correlatedValues[i] = sourceRecord.Values[fieldIndex]
+			}
+			key, err := spill.EncodeValues(correlatedValues)
+			if err != nil {
+				return fmt.Errorf("couldn't encode lookup join cache key: %w", err)
+			}
+
+			if running, ok := running[string(key)]; ok {
+				result = running
+			} else if cached, ok := cache.Get(string(key)); ok {
+				result = cached.(*lookupResult)
+			} else {
+				result = &lookupResult{key: string(key)}
+			}
+		} else {
+			result = &lookupResult{}
+		}
+
+		if result.done == nil {
+			result.done = make(chan struct{})
+			if cache != nil {
+				running[result.key] = result
+			}
+			if s.concurrency <= 1 {
+				runJoined(sourceRecord, result)
+			} else {
+				semaphore <- struct{}{}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer func() { <-semaphore }()
+
+					runJoined(sourceRecord, result)
+				}()
+			}
 		}
+		pending = append(pending, lookupJob{sourceRecord: sourceRecord, result: result})
 
+		// Finished jobs are emitted right away, as long as they're not preceded by unfinished ones.
+		for len(pending) > 0 && (len(pending) > s.concurrency || isDone(pending[0])) {
+			if err := emit(pending[0]); err != nil {
+				return err
+			}
+			pending = pending[1:]
+		}
 		return nil
-	}, metaSend); err != nil {
+	}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+		// Metadata has to follow all the records preceding it.
+		if err := emitAll(); err != nil {
+			return err
+		}
+		return metaSend(produceCtx, msg)
+	}); err != nil {
 		return fmt.Errorf("couldn't run source: %w", err)
 	}
-	return nil
+
+	return emitAll()
 }
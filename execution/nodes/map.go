@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	. "github.com/cube2222/octosql/execution"
-	"github.com/cube2222/octosql/octosql"
 )
 
 type Map struct {
@@ -20,19 +19,26 @@ func NewMap(source Node, exprs []Expression) *Map {
 }
 
 func (m *Map) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
-	if err := m.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
-		ctx := ctx.WithRecord(record)
-
-		// TODO: Reuse this slice on every produce call? NO, because of stream join for instance.
-		values := make([]octosql.Value, len(m.exprs))
-		for i, expr := range m.exprs {
-			value, err := expr.Evaluate(ctx)
-			if err != nil {
-				return fmt.Errorf("couldn't evaluate %d map expression: %w", i, err)
+	return m.RunBatch(ctx, ProduceBatchRecords(produce), metaSend)
+}
+
+func (m *Map) RunBatch(ctx ExecutionContext, produce ProduceBatchFn, metaSend MetaSendFn) error {
+	out := NewRecordBatch(len(m.exprs))
+	if err := RunBatch(m.source, ctx, func(produceCtx ProduceContext, batch *RecordBatch) error {
+		out.Reset()
+		for _, record := range batch.Records {
+			ctx := ctx.WithRecord(record)
+
+			values := out.Append(record.Retraction, record.EventTime)
+			for i, expr := range m.exprs {
+				value, err := expr.Evaluate(ctx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate %d map expression: %w", i, err)
+				}
+				values[i] = value
 			}
-			values[i] = value
 		}
-		if err := produce(produceCtx, NewRecord(values, record.Retraction, record.EventTime)); err != nil {
+		if err := produce(produceCtx, out); err != nil {
 			return fmt.Errorf("couldn't produce: %w", err)
 		}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/google/btree"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
 	"github.com/cube2222/octosql/octosql"
 )
 
@@ -15,16 +16,22 @@ type OrderSensitiveTransform struct {
 	orderByKeyExprs             []Expression
 	orderByDirectionMultipliers []int
 	limit                       *Expression
+	offset                      *Expression
 	noRetractionsPossible       bool
+	// Once the records take up more than the memory budget, they get spilled to disk as a sorted run,
+	// and the runs are merged when producing the results. Disabled if zero.
+	memoryBudget int
 }
 
-func NewOrderSensitiveTransform(source Node, orderByKeyExprs []Expression, orderByDirectionMultipliers []int, limit *Expression, noRetractionsPossible bool) *OrderSensitiveTransform {
+func NewOrderSensitiveTransform(source Node, orderByKeyExprs []Expression, orderByDirectionMultipliers []int, limit *Expression, offset *Expression, noRetractionsPossible bool, memoryBudget int) *OrderSensitiveTransform {
 	return &OrderSensitiveTransform{
 		source:                      source,
 		orderByKeyExprs:             orderByKeyExprs,
 		orderByDirectionMultipliers: orderByDirectionMultipliers,
 		limit:                       limit,
+		offset:                      offset,
 		noRetractionsPossible:       noRetractionsPossible,
+		memoryBudget:                memoryBudget,
 	}
 }
 
@@ -72,9 +79,43 @@ func (o *OrderSensitiveTransform) Run(execCtx ExecutionContext, produce ProduceF
 		}
 		limit = &val.Int
 	}
+	offset := 0
+	if o.offset != nil {
+		val, err := (*o.offset).Evaluate(execCtx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate offset: %w", err)
+		}
+		if val.Int < 0 {
+			return fmt.Errorf("offset must be non-negative, got %d", val.Int)
+		}
+		offset = val.Int
+	}
 
 	recordCounts := btree.New(BTreeDefaultDegree)
-	o.source.Run(
+	stateSize := 0
+	spilled := spill.NewSorter(o.memoryBudget, func(a, b *spill.Entry) bool {
+		return (&orderByItem{Key: a.Key, Values: a.Values, DirectionMultipliers: o.orderByDirectionMultipliers}).
+			Less(&orderByItem{Key: b.Key, Values: b.Values, DirectionMultipliers: o.orderByDirectionMultipliers})
+	})
+	defer spilled.Close()
+	spillRecordCounts := func() error {
+		if err := spilled.AddRun(func(add func(entry *spill.Entry) error) error {
+			var err error
+			recordCounts.Ascend(func(item btree.Item) bool {
+				itemTyped := item.(*orderByItem)
+				err = add(&spill.Entry{Key: itemTyped.Key, Values: itemTyped.Values, Count: itemTyped.Count})
+				return err == nil
+			})
+			return err
+		}); err != nil {
+			return fmt.Errorf("couldn't spill records: %w", err)
+		}
+		recordCounts.Clear(false)
+		stateSize = 0
+		return nil
+	}
+
+	if err := o.source.Run(
 		execCtx,
 		func(ctx ProduceContext, record Record) error {
 			key := make([]octosql.Value, len(o.orderByKeyExprs))
@@ -107,42 +148,71 @@ func (o *OrderSensitiveTransform) Run(execCtx ExecutionContext, produce ProduceF
 			} else {
 				itemTyped.Count--
 			}
-			if itemTyped.Count > 0 {
+			// Once records have been spilled, a retraction may arrive before the record it retracts gets merged with it.
+			if itemTyped.Count > 0 || (spilled.Spilled() && itemTyped.Count != 0) {
+				if item == nil {
+					stateSize += spill.Size(key) + spill.Size(record.Values)
+				}
 				recordCounts.ReplaceOrInsert(itemTyped)
-			} else {
+			} else if item != nil {
 				recordCounts.Delete(itemTyped)
+				stateSize -= spill.Size(key) + spill.Size(record.Values)
 			}
-			if limit != nil && o.noRetractionsPossible && recordCounts.Len() > *limit {
+			if limit != nil && o.noRetractionsPossible && recordCounts.Len() > *limit+offset {
 				// This doesn't mean we'll always keep just the records that are needed, because tree nodes might have count > 1.
 				// That said, it's a good approximation, and we'll definitely not lose something that we need to have.
-				recordCounts.DeleteMax()
+				deleted := recordCounts.DeleteMax().(*orderByItem)
+				stateSize -= spill.Size(deleted.Key) + spill.Size(deleted.Values)
+			}
+			if o.memoryBudget > 0 && stateSize > o.memoryBudget {
+				if err := spillRecordCounts(); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error {
 			return nil
 		},
-	)
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	if spilled.Spilled() {
+		if err := spillRecordCounts(); err != nil {
+			return err
+		}
+		if err := produceSpilledOrderByItems(ProduceFromExecutionContext(execCtx), spilled, limit, offset, produce); err != nil {
+			return fmt.Errorf("couldn't produce ordered spilled items: %w", err)
+		}
+		return nil
+	}
 
-	if err := produceOrderByItems(ProduceFromExecutionContext(execCtx), recordCounts, limit, produce); err != nil {
+	if err := produceOrderByItems(ProduceFromExecutionContext(execCtx), recordCounts, limit, offset, produce); err != nil {
 		return fmt.Errorf("couldn't produce ordered items: %w", err)
 	}
 	return nil
 }
 
-func produceOrderByItems(ctx ProduceContext, recordCounts *btree.BTree, limit *int, produce ProduceFn) error {
+func produceOrderByItems(ctx ProduceContext, recordCounts *btree.BTree, limit *int, offset int, produce ProduceFn) error {
+	// Both the limit and the offset count records, not distinct items.
+	skipped := 0
 	i := 0
 	var outErr error
 	recordCounts.Ascend(func(item btree.Item) bool {
-		if limit != nil && i >= *limit {
-			return false
-		}
-		i++
 		itemTyped, ok := item.(*orderByItem)
 		if !ok {
 			panic(fmt.Sprintf("invalid order by item: %v", item))
 		}
-		for i := 0; i < itemTyped.Count; i++ {
+		for j := 0; j < itemTyped.Count; j++ {
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			if limit != nil && i >= *limit {
+				return false
+			}
+			i++
 			if err := produce(ctx, NewRecord(itemTyped.Values, false, time.Time{})); err != nil {
 				outErr = err
 				return false
@@ -152,3 +222,49 @@ func produceOrderByItems(ctx ProduceContext, recordCounts *btree.BTree, limit *i
 	})
 	return outErr
 }
+
+// produceSpilledOrderByItems merges the spilled runs, summing the counts of equal items across them.
+func produceSpilledOrderByItems(ctx ProduceContext, spilled *spill.Sorter, limit *int, offset int, produce ProduceFn) error {
+	entries, err := spilled.Merge()
+	if err != nil {
+		return fmt.Errorf("couldn't merge spilled records: %w", err)
+	}
+	defer entries.Close()
+
+	// Both the limit and the offset count records, not distinct items.
+	skipped := 0
+	i := 0
+	for {
+		entry, ok, err := entries.Next()
+		if err != nil {
+			return fmt.Errorf("couldn't read spilled records: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+		for {
+			next, ok := entries.Peek()
+			if !ok || !valueSlicesEqual(next.Key, entry.Key) || !valueSlicesEqual(next.Values, entry.Values) {
+				break
+			}
+			entry.Count += next.Count
+			if _, _, err := entries.Next(); err != nil {
+				return fmt.Errorf("couldn't read spilled records: %w", err)
+			}
+		}
+
+		for j := 0; j < entry.Count; j++ {
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			if limit != nil && i >= *limit {
+				return nil
+			}
+			i++
+			if err := produce(ctx, NewRecord(entry.Values, false, time.Time{})); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	tbtree "github.com/tidwall/btree"
-
 	. "github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
 )
@@ -15,18 +13,31 @@ type OuterJoin struct {
 	leftFieldCount, rightFieldCount int
 	keyExprsLeft, keyExprsRight     []Expression
 	isOuterLeft, isOuterRight       bool
+	// If timeBound is set, only records satisfying it are joined,
+	// and records which can't be joined with any future records get evicted from the join state.
+	// Records without a match are then sent with NULLs on the other side only once they get evicted.
+	timeBound *JoinTimeBound
+	// Records are kept in the join state for the allowed lateness longer, so that late records can still be joined with them.
+	lateRecords *LateRecords
+	// Both sides get half of the memory budget, see joinState. Disabled if zero.
+	memoryBudget         int
+	watermarkIdleTimeout time.Duration
 }
 
-func NewOuterJoin(left, right Node, leftFieldCount, rightFieldCount int, keyExprsLeft, keyExprsRight []Expression, isOuterLeft, isOuterRight bool) *OuterJoin {
+func NewOuterJoin(left, right Node, leftFieldCount, rightFieldCount int, keyExprsLeft, keyExprsRight []Expression, isOuterLeft, isOuterRight bool, timeBound *JoinTimeBound, lateRecords *LateRecords, memoryBudget int, watermarkIdleTimeout time.Duration) *OuterJoin {
 	return &OuterJoin{
-		left:            left,
-		right:           right,
-		leftFieldCount:  leftFieldCount,
-		rightFieldCount: rightFieldCount,
-		keyExprsLeft:    keyExprsLeft,
-		keyExprsRight:   keyExprsRight,
-		isOuterLeft:     isOuterLeft,
-		isOuterRight:    isOuterRight,
+		left:                 left,
+		right:                right,
+		leftFieldCount:       leftFieldCount,
+		rightFieldCount:      rightFieldCount,
+		keyExprsLeft:         keyExprsLeft,
+		keyExprsRight:        keyExprsRight,
+		isOuterLeft:          isOuterLeft,
+		isOuterRight:         isOuterRight,
+		timeBound:            timeBound,
+		lateRecords:          lateRecords,
+		memoryBudget:         memoryBudget,
+		watermarkIdleTimeout: watermarkIdleTimeout,
 	}
 }
 
@@ -89,28 +100,30 @@ func (s *OuterJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSe
 		close(rightMessages)
 	}()
 
-	leftRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
-		return CompareValueSlices(a.GroupKey, b.GroupKey)
-	}, tbtree.Options{
-		NoLocks: true,
-	})
-	rightRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
-		return CompareValueSlices(a.GroupKey, b.GroupKey)
-	}, tbtree.Options{
-		NoLocks: true,
-	})
+	leftRecords := newJoinState(s.memoryBudget / 2)
+	defer leftRecords.close()
+	rightRecords := newJoinState(s.memoryBudget / 2)
+	defer rightRecords.close()
+
+	var leftEvictionQueue, rightEvictionQueue *joinEvictionQueue
+	if s.timeBound != nil {
+		leftEvictionQueue = newJoinEvictionQueue(s.timeBound.LeftTimeFieldIndex)
+		rightEvictionQueue = newJoinEvictionQueue(s.timeBound.RightTimeFieldIndex)
+	}
 
 	var leftDone bool
 
-	var leftWatermark, rightWatermark, minWatermark time.Time
+	watermarks := newInputWatermarks(2, s.watermarkIdleTimeout)
+	defer watermarks.stop()
+	var minWatermark time.Time
 
-	leftRecordBuffer := NewRecordEventTimeBuffer()
-	rightRecordBuffer := NewRecordEventTimeBuffer()
+	leftRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
+	rightRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
 
 	processRecordsUpTo := func(ctx ExecutionContext, watermark time.Time) error {
 		if rightRecords != nil {
 			if err := leftRecordBuffer.Emit(watermark, func(record Record) error {
-				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, true, record); err != nil {
+				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, leftEvictionQueue, true, record); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from left: %w", err)
 				}
@@ -123,7 +136,7 @@ func (s *OuterJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSe
 
 		if leftRecords != nil {
 			if err := rightRecordBuffer.Emit(watermark, func(record Record) error {
-				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, false, record); err != nil {
+				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, rightEvictionQueue, false, record); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from right: %w", err)
 				}
@@ -137,6 +150,55 @@ func (s *OuterJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSe
 		return nil
 	}
 
+	evictRecordsUpTo := func(ctx ExecutionContext, watermark time.Time) error {
+		if s.timeBound == nil {
+			return nil
+		}
+		// At the end of the stream, all remaining records are final.
+		all := watermark == WatermarkMaxValue
+		watermark = watermark.Add(-s.lateRecords.AllowedLateness())
+
+		if threshold, ok := s.timeBound.evictionThreshold(true, watermark); ok || all {
+			if err := leftEvictionQueue.evict(leftRecords, threshold, all, func(subitem *streamJoinSubitem) error {
+				return s.produceUnmatched(ctx, produce, subitem, true)
+			}); err != nil {
+				return fmt.Errorf("couldn't evict left records: %w", err)
+			}
+		}
+		if threshold, ok := s.timeBound.evictionThreshold(false, watermark); ok || all {
+			if err := rightEvictionQueue.evict(rightRecords, threshold, all, func(subitem *streamJoinSubitem) error {
+				return s.produceUnmatched(ctx, produce, subitem, false)
+			}); err != nil {
+				return fmt.Errorf("couldn't evict right records: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// advanceWatermark processes the buffered records and sends the watermark, if the minimum watermark of the inputs has risen.
+	advanceWatermark := func() error {
+		min := watermarks.min()
+		if !min.After(minWatermark) {
+			return nil
+		}
+		minWatermark = min
+
+		if err := processRecordsUpTo(ctx, minWatermark); err != nil {
+			return err
+		}
+		if err := evictRecordsUpTo(ctx, minWatermark); err != nil {
+			return err
+		}
+
+		if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+			Type:      MetadataMessageTypeWatermark,
+			Watermark: s.outputWatermark(minWatermark),
+		}); err != nil {
+			return fmt.Errorf("couldn't send metadata: %w", err)
+		}
+		return nil
+	}
+
 receiveLoop:
 	for {
 		select {
@@ -148,41 +210,25 @@ receiveLoop:
 			if msg.err != nil {
 				return msg.err
 			}
+			watermarks.received(0)
 			if msg.metadata {
-				leftWatermark = msg.metadataMessage.Watermark
-
-				var min time.Time
-				if leftWatermark.After(rightWatermark) {
-					min = rightWatermark
-				} else {
-					min = leftWatermark
+				watermarks.setWatermark(0, msg.metadataMessage.Watermark)
+				if err := advanceWatermark(); err != nil {
+					return err
 				}
-				if min.After(minWatermark) {
-					minWatermark = min
-
-					if err := processRecordsUpTo(ctx, minWatermark); err != nil {
-						return err
-					}
-
-					if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
-						Type:      MetadataMessageTypeWatermark,
-						Watermark: minWatermark,
-					}); err != nil {
-						return fmt.Errorf("couldn't send metadata: %w", err)
-					}
-				}
-
 				continue
 			}
 			if msg.record.EventTime.IsZero() {
 				// If the event time is zero, don't buffer, there's no point.
 				// There won't be any record with an event time less than zero.
-				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, true, msg.record); err != nil {
+				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, leftEvictionQueue, true, msg.record); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from left: %w", err)
 				}
 			} else {
-				leftRecordBuffer.AddRecord(msg.record)
+				if err := leftRecordBuffer.AddRecord(msg.record); err != nil {
+					return fmt.Errorf("couldn't buffer record: %w", err)
+				}
 			}
 			// TODO: Add backpressure
 
@@ -194,76 +240,86 @@ receiveLoop:
 			if msg.err != nil {
 				return msg.err
 			}
+			watermarks.received(1)
 			if msg.metadata {
-				rightWatermark = msg.metadataMessage.Watermark
-
-				var min time.Time
-				if rightWatermark.After(leftWatermark) {
-					min = leftWatermark
-				} else {
-					min = rightWatermark
+				watermarks.setWatermark(1, msg.metadataMessage.Watermark)
+				if err := advanceWatermark(); err != nil {
+					return err
 				}
-				if min.After(minWatermark) {
-					minWatermark = min
-
-					if err := processRecordsUpTo(ctx, minWatermark); err != nil {
-						return err
-					}
-
-					if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
-						Type:      MetadataMessageTypeWatermark,
-						Watermark: minWatermark,
-					}); err != nil {
-						return fmt.Errorf("couldn't send metadata: %w", err)
-					}
-				}
-
 				continue
 			}
 			if msg.record.EventTime.IsZero() {
 				// If the event time is zero, don't buffer, there's no point.
 				// There won't be any record with an event time less than zero.
-				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, false, msg.record); err != nil {
+				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, rightEvictionQueue, false, msg.record); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from right: %w", err)
 				}
 			} else {
-				rightRecordBuffer.AddRecord(msg.record)
+				if err := rightRecordBuffer.AddRecord(msg.record); err != nil {
+					return fmt.Errorf("couldn't buffer record: %w", err)
+				}
 			}
 			// TODO: Add backpressure
+
+		case <-watermarks.idleCheck():
+			watermarks.markIdle()
+			if err := advanceWatermark(); err != nil {
+				return err
+			}
 		}
 	}
 
 	var openChannel chan chanMessage
 	var myRecordBuffer *RecordEventTimeBuffer
-	var myRecords, otherRecords *tbtree.Generic[*streamJoinItem]
+	var myRecords, otherRecords *joinState
+	var myEvictionQueue *joinEvictionQueue
+	var myWatermark time.Time
 	if !leftDone {
 		openChannel = leftMessages
 		myRecords = leftRecords
 		myRecordBuffer = leftRecordBuffer
-		minWatermark = leftWatermark
+		myEvictionQueue = leftEvictionQueue
+		myWatermark = watermarks.watermark(0)
 		otherRecords = rightRecords
 	} else {
 		openChannel = rightMessages
 		myRecords = rightRecords
 		myRecordBuffer = rightRecordBuffer
-		minWatermark = rightWatermark
+		myEvictionQueue = rightEvictionQueue
+		myWatermark = watermarks.watermark(1)
 		otherRecords = leftRecords
 	}
+	// If the remaining input has been idle, it may be behind the watermark which has already been sent.
+	if myWatermark.After(minWatermark) {
+		minWatermark = myWatermark
+	}
 
 	if err := processRecordsUpTo(ctx, minWatermark); err != nil {
 		return err
 	}
+	if err := evictRecordsUpTo(ctx, minWatermark); err != nil {
+		return err
+	}
 
 	for msg := range openChannel {
 		if msg.err != nil {
 			return msg.err
 		}
 		if msg.metadata {
+			if !msg.metadataMessage.Watermark.After(minWatermark) {
+				continue
+			}
+			minWatermark = msg.metadataMessage.Watermark
+
 			if err := processRecordsUpTo(ctx, msg.metadataMessage.Watermark); err != nil {
 				return err
 			}
+			if err := evictRecordsUpTo(ctx, msg.metadataMessage.Watermark); err != nil {
+				return err
+			}
 
+			msg.metadataMessage.Watermark = s.outputWatermark(msg.metadataMessage.Watermark)
 			if err := metaSend(ProduceFromExecutionContext(ctx), msg.metadataMessage); err != nil {
 				return fmt.Errorf("couldn't send metadata: %w", err)
 			}
@@ -273,22 +329,48 @@ receiveLoop:
 		if msg.record.EventTime.IsZero() {
 			// If the event time is zero, don't buffer, there's no point.
 			// There won't be any record with an event time less than zero.
-			if err := s.receiveRecord(ctx, produce, myRecords, otherRecords, !leftDone, msg.record); err != nil {
+			if err := s.receiveRecord(ctx, produce, myRecords, otherRecords, myEvictionQueue, !leftDone, msg.record); err != nil {
 				return fmt.Errorf("couldn't process record: %w", err)
 			}
 		} else {
-			myRecordBuffer.AddRecord(msg.record)
+			if err := myRecordBuffer.AddRecord(msg.record); err != nil {
+				return fmt.Errorf("couldn't buffer record: %w", err)
+			}
 		}
 	}
 
 	if err := processRecordsUpTo(ctx, WatermarkMaxValue); err != nil {
 		return err
 	}
+	if err := evictRecordsUpTo(ctx, WatermarkMaxValue); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (s *OuterJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *tbtree.Generic[*streamJoinItem], amLeft bool, record Record) error {
+// outputWatermark holds back the watermark of time bounded joins,
+// so that the unmatched records sent with NULLs on eviction aren't late.
+func (s *OuterJoin) outputWatermark(watermark time.Time) time.Time {
+	if s.timeBound == nil {
+		return watermark
+	}
+	var delay time.Duration
+	if s.isOuterLeft {
+		delay = s.timeBound.maxEvictionDelay(true)
+	}
+	if s.isOuterRight && s.timeBound.maxEvictionDelay(false) > delay {
+		delay = s.timeBound.maxEvictionDelay(false)
+	}
+	// Eviction is also postponed by the allowed lateness.
+	return watermark.Add(-delay - s.lateRecords.AllowedLateness())
+}
+
+func (s *OuterJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *joinState, myEvictionQueue *joinEvictionQueue, amLeft bool, record Record) error {
+	if s.timeBound != nil {
+		return s.receiveRecordTimeBounded(ctx, produce, myRecords, otherRecords, myEvictionQueue, amLeft, record)
+	}
+
 	ctx = ctx.WithRecord(record)
 
 	var keyExprs []Expression
@@ -307,47 +389,20 @@ func (s *OuterJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRec
 		key[i] = value
 	}
 
-	firstRecordForThatKeyOnThisSide := false
-	lastRetractionForThatKeyOnThisSide := false
-	{
-		// Update count in my record tree
-		itemTyped, ok := myRecords.Get(&streamJoinItem{GroupKey: key})
-
-		if !ok {
-			itemTyped = &streamJoinItem{GroupKey: key, values: tbtree.NewGenericOptions(func(a, b *streamJoinSubitem) bool {
-				return CompareValueSlices(a.GroupKey, b.GroupKey)
-			}, tbtree.Options{NoLocks: true})}
-			myRecords.Set(itemTyped)
-			firstRecordForThatKeyOnThisSide = true
-		}
-
-		{
-			subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
-
-			if !ok {
-				subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
-				itemTyped.values.Set(subitemTyped)
-			}
-			if !record.Retraction {
-				subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
-			} else {
-				// TODO: This should delete the matching event time.
-				subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
-			}
-			if len(subitemTyped.EventTimes) == 0 {
-				itemTyped.values.Delete(subitemTyped)
-			}
-		}
-
-		if itemTyped.values.Len() == 0 {
-			myRecords.Delete(itemTyped)
-			lastRetractionForThatKeyOnThisSide = true
-		}
+	// Update count in my record tree
+	update, err := myRecords.update(key, record)
+	if err != nil {
+		return err
 	}
+	firstRecordForThatKeyOnThisSide := update.firstForKey
+	lastRetractionForThatKeyOnThisSide := update.lastForKey
 
 	// Trigger with all matching records from other record tree
 	{
-		itemTyped, ok := otherRecords.Get(&streamJoinItem{GroupKey: key})
+		itemTyped, ok, err := otherRecords.get(key)
+		if err != nil {
+			return err
+		}
 
 		if !ok || itemTyped.values.Len() == 0 {
 			if s.isOuterLeft && amLeft {
@@ -457,3 +512,122 @@ func (s *OuterJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRec
 
 	return nil
 }
+
+// receiveRecordTimeBounded joins the record with the records of the other side satisfying the time bound.
+// Unlike in the unbounded case, records without a match aren't sent with NULLs right away, but once they get evicted.
+func (s *OuterJoin) receiveRecordTimeBounded(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *joinState, myEvictionQueue *joinEvictionQueue, amLeft bool, record Record) error {
+	ctx = ctx.WithRecord(record)
+
+	var keyExprs []Expression
+	if amLeft {
+		keyExprs = s.keyExprsLeft
+	} else {
+		keyExprs = s.keyExprsRight
+	}
+
+	key := make(GroupKey, len(keyExprs))
+	for i, expr := range keyExprs {
+		value, err := expr.Evaluate(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate %d stream join key expression: %w", i, err)
+		}
+		key[i] = value
+	}
+
+	// The subitem of this record, if it's been newly added to my record tree.
+	var newSubitem *streamJoinSubitem
+	// A retraction of a record which has already been evicted is still joined with the remaining records of the other side.
+	updateMyRecords := !record.Retraction
+	if !updateMyRecords {
+		var err error
+		if updateMyRecords, err = myRecords.contains(key, record.Values); err != nil {
+			return err
+		}
+	}
+	if updateMyRecords {
+		// Update count in my record tree
+		update, err := myRecords.update(key, record)
+		if err != nil {
+			return err
+		}
+		if update.newSubitem {
+			myEvictionQueue.add(key, record.Values)
+			newSubitem = update.subitem
+		}
+		if update.removedSubitem {
+			myEvictionQueue.remove(key, record.Values)
+		}
+	}
+
+	// Trigger with all matching records from other record tree
+	itemTyped, ok, err := otherRecords.get(key)
+	if err != nil || !ok {
+		return err
+	}
+
+	diff := 1
+	if record.Retraction {
+		diff = -1
+	}
+
+	var outErr error
+	itemTyped.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
+		if !timeBoundMatches(s.timeBound, amLeft, record.Values, subitemTyped.GroupKey) {
+			return true
+		}
+		if err := otherRecords.addMatches(key, subitemTyped, diff); err != nil {
+			outErr = err
+			return false
+		}
+		if newSubitem != nil {
+			if err := myRecords.addMatches(key, newSubitem, len(subitemTyped.EventTimes)); err != nil {
+				outErr = err
+				return false
+			}
+		}
+
+		for i := 0; i < len(subitemTyped.EventTimes); i++ {
+			outputValues := make([]octosql.Value, len(record.Values)+len(subitemTyped.GroupKey))
+
+			eventTime := record.EventTime
+			if subitemTyped.EventTimes[i].After(eventTime) {
+				eventTime = subitemTyped.EventTimes[i]
+			}
+
+			if amLeft {
+				copy(outputValues, record.Values)
+				copy(outputValues[len(record.Values):], subitemTyped.GroupKey)
+			} else {
+				copy(outputValues, subitemTyped.GroupKey)
+				copy(outputValues[len(subitemTyped.GroupKey):], record.Values)
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, record.Retraction, eventTime)); err != nil {
+				outErr = fmt.Errorf("couldn't produce: %w", err)
+				return false
+			}
+		}
+
+		return true
+	})
+	return outErr
+}
+
+// produceUnmatched sends an evicted record with NULLs on the other side, if it's on an outer side of the join and had no match.
+func (s *OuterJoin) produceUnmatched(ctx ExecutionContext, produce ProduceFn, subitem *streamJoinSubitem, isLeft bool) error {
+	if subitem.Matches > 0 || (isLeft && !s.isOuterLeft) || (!isLeft && !s.isOuterRight) {
+		return nil
+	}
+	for _, eventTime := range subitem.EventTimes {
+		outputValues := make([]octosql.Value, s.leftFieldCount+s.rightFieldCount)
+		if isLeft {
+			copy(outputValues, subitem.GroupKey)
+		} else {
+			copy(outputValues[s.leftFieldCount:], subitem.GroupKey)
+		}
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, false, eventTime)); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+	return nil
+}
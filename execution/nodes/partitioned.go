@@ -0,0 +1,212 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+// Partitioned runs a separate instance of a node for each partition of its source, in parallel.
+// Records are assigned to partitions by the hash of their partition key, so all records with equal keys are processed by the same instance, in their original order.
+// Watermarks are sent to all instances, and the minimum of the watermarks sent by the instances is forwarded.
+// The order of the output records of different instances is arbitrary.
+type Partitioned struct {
+	source       Node
+	partitionKey []Expression
+	partitions   int
+	newPartition func(source Node) Node
+}
+
+func NewPartitioned(source Node, partitionKey []Expression, partitions int, newPartition func(source Node) Node) *Partitioned {
+	return &Partitioned{
+		source:       source,
+		partitionKey: partitionKey,
+		partitions:   partitions,
+		newPartition: newPartition,
+	}
+}
+
+// partitionBatchSize is the number of records sent to a partition at once.
+const partitionBatchSize = 256
+
+type partitionMessage struct {
+	records   []Record
+	watermark *time.Time
+}
+
+func (p *Partitioned) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	runCtx, cancel := context.WithCancel(ctx.Context)
+	defer cancel()
+	ctx = ExecutionContext{Context: runCtx, VariableContext: ctx.VariableContext}
+
+	var errMutex sync.Mutex
+	var firstErr error
+	setErr := func(err error) {
+		errMutex.Lock()
+		defer errMutex.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		cancel()
+	}
+
+	// The output is shared by all instances.
+	var outputMutex sync.Mutex
+	watermarks := newInputWatermarks(p.partitions, 0)
+	var minWatermark time.Time
+	forwardWatermark := func(produceCtx ProduceContext) error {
+		min := watermarks.min()
+		if min.After(minWatermark) && min != WatermarkMaxValue {
+			minWatermark = min
+
+			if err := metaSend(produceCtx, MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+		return nil
+	}
+
+	channels := make([]chan partitionMessage, p.partitions)
+	var wg sync.WaitGroup
+	for i := range channels {
+		channels[i] = make(chan partitionMessage, 16)
+		node := p.newPartition(&partitionSource{messages: channels[i]})
+
+		wg.Add(1)
+		go func(partition int) {
+			defer wg.Done()
+
+			if err := node.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+				outputMutex.Lock()
+				defer outputMutex.Unlock()
+				if err := runCtx.Err(); err != nil {
+					return err
+				}
+
+				return produce(produceCtx, record)
+			}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+				outputMutex.Lock()
+				defer outputMutex.Unlock()
+				if err := runCtx.Err(); err != nil {
+					return err
+				}
+
+				if msg.Type != MetadataMessageTypeWatermark {
+					return metaSend(produceCtx, msg)
+				}
+				watermarks.setWatermark(partition, msg.Watermark)
+				return forwardWatermark(produceCtx)
+			}); err != nil {
+				setErr(fmt.Errorf("couldn't run partition %d: %w", partition, err))
+				return
+			}
+
+			outputMutex.Lock()
+			defer outputMutex.Unlock()
+			watermarks.finished(partition)
+			if err := forwardWatermark(ProduceFromExecutionContext(ctx)); err != nil {
+				setErr(err)
+			}
+		}(i)
+	}
+
+	batches := make([][]Record, p.partitions)
+	send := func(partition int, msg partitionMessage) error {
+		select {
+		case channels[partition] <- msg:
+			return nil
+		case <-runCtx.Done():
+			return runCtx.Err()
+		}
+	}
+	flush := func(partition int) error {
+		if len(batches[partition]) == 0 {
+			return nil
+		}
+		if err := send(partition, partitionMessage{records: batches[partition]}); err != nil {
+			return err
+		}
+		batches[partition] = make([]Record, 0, partitionBatchSize)
+		return nil
+	}
+
+	hash := fnv.New64a()
+	err := p.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		hash.Reset()
+		recordCtx := ctx.WithRecord(record)
+		for i, expr := range p.partitionKey {
+			value, err := expr.Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d partition key expression: %w", i, err)
+			}
+			value.Hash(hash)
+		}
+		partition := int(hash.Sum64() % uint64(p.partitions))
+
+		batches[partition] = append(batches[partition], record)
+		if len(batches[partition]) >= partitionBatchSize {
+			return flush(partition)
+		}
+		return nil
+	}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+		// Records sent before the watermark have to reach the instances before it.
+		for partition := range channels {
+			if err := flush(partition); err != nil {
+				return err
+			}
+			watermark := msg.Watermark
+			if err := send(partition, partitionMessage{watermark: &watermark}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		for partition := range channels {
+			if err = flush(partition); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		setErr(fmt.Errorf("couldn't run source: %w", err))
+	}
+	for i := range channels {
+		close(channels[i])
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// partitionSource produces the records sent to a single partition.
+type partitionSource struct {
+	messages <-chan partitionMessage
+}
+
+func (s *partitionSource) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for msg := range s.messages {
+		if msg.watermark != nil {
+			if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: *msg.watermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+			continue
+		}
+		for _, record := range msg.records {
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce record: %w", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package nodes
+
+import (
+	"fmt"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// RecursiveCTEWorkingTable holds the records produced by the previous iteration of a recursive common table expression.
+type RecursiveCTEWorkingTable struct {
+	records []Record
+}
+
+// RecursiveCTE evaluates a recursive common table expression using semi-naive iteration.
+// The anchor part is run once, after which the recursive part is run repeatedly,
+// each time reading only the records produced by the previous iteration, until it produces no new records.
+type RecursiveCTE struct {
+	anchor, recursive Node
+	workingTable      *RecursiveCTEWorkingTable
+	// If distinct is set, records which have already been produced are discarded.
+	distinct      bool
+	maxIterations int
+	// Fixes the layout of object fields, which may differ between both parts.
+	fieldLayoutFixers []*ObjectLayoutFixer
+}
+
+func NewRecursiveCTE(anchor, recursive Node, workingTable *RecursiveCTEWorkingTable, distinct bool, maxIterations int, fieldLayoutFixers []*ObjectLayoutFixer) *RecursiveCTE {
+	return &RecursiveCTE{
+		anchor:            anchor,
+		recursive:         recursive,
+		workingTable:      workingTable,
+		distinct:          distinct,
+		maxIterations:     maxIterations,
+		fieldLayoutFixers: fieldLayoutFixers,
+	}
+}
+
+func (r *RecursiveCTE) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	seen := btree.New(BTreeDefaultDegree)
+
+	runPart := func(source Node, sourceIndex int) ([]Record, error) {
+		var newRecords []Record
+		if err := source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			if record.Retraction {
+				return fmt.Errorf("recursive common table expressions don't support retractions")
+			}
+
+			values := make([]octosql.Value, len(record.Values))
+			for i := range record.Values {
+				values[i] = r.fieldLayoutFixers[i].FixLayout(sourceIndex, record.Values[i])
+			}
+			if r.distinct {
+				if seen.Has(GroupKey(values)) {
+					return nil
+				}
+				seen.ReplaceOrInsert(GroupKey(values))
+			}
+
+			record = NewRecord(values, false, record.EventTime)
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce record: %w", err)
+			}
+			newRecords = append(newRecords, record)
+			return nil
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			// The output has no event time field, so watermarks don't apply to it.
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return newRecords, nil
+	}
+
+	delta, err := runPart(r.anchor, 0)
+	if err != nil {
+		return fmt.Errorf("couldn't run anchor part: %w", err)
+	}
+
+	for iteration := 0; len(delta) > 0; iteration++ {
+		if iteration == r.maxIterations {
+			return fmt.Errorf("recursive common table expression didn't finish after the maximum of %d iterations", r.maxIterations)
+		}
+
+		r.workingTable.records = delta
+		if delta, err = runPart(r.recursive, 1); err != nil {
+			return fmt.Errorf("couldn't run recursive part in iteration %d: %w", iteration+1, err)
+		}
+	}
+	r.workingTable.records = nil
+
+	return nil
+}
+
+// RecursiveCTEReference reads the working table of a recursive common table expression.
+type RecursiveCTEReference struct {
+	workingTable *RecursiveCTEWorkingTable
+}
+
+func NewRecursiveCTEReference(workingTable *RecursiveCTEWorkingTable) *RecursiveCTEReference {
+	return &RecursiveCTEReference{
+		workingTable: workingTable,
+	}
+}
+
+func (r *RecursiveCTEReference) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for _, record := range r.workingTable.records {
+		if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	tbtree "github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// SemiJoin passes through the records of the left side which have a matching key on the right side.
+// If isAnti is set, it instead passes through the records which don't, following the SQL semantics of NOT IN:
+// - If the right side is empty, all records pass through.
+// - Otherwise, records with a NULL key don't pass through.
+// - Otherwise, if the right side contains a NULL key, no records pass through.
+//
+// Changes on the right side cause retractions and insertions of the affected left records,
+// so the node works incrementally.
+type SemiJoin struct {
+	left, right                 Node
+	keyExprsLeft, keyExprsRight []Expression
+	isAnti                      bool
+	lateRecords                 *LateRecords
+	watermarkIdleTimeout        time.Duration
+}
+
+func NewSemiJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, isAnti bool, lateRecords *LateRecords, watermarkIdleTimeout time.Duration) *SemiJoin {
+	return &SemiJoin{
+		left:                 left,
+		right:                right,
+		keyExprsLeft:         keyExprsLeft,
+		keyExprsRight:        keyExprsRight,
+		isAnti:               isAnti,
+		lateRecords:          lateRecords,
+		watermarkIdleTimeout: watermarkIdleTimeout,
+	}
+}
+
+type semiJoinRightItem struct {
+	GroupKey
+	Count int
+}
+
+type semiJoinState struct {
+	// Left records, indexed by key.
+	leftRecords *tbtree.Generic[*streamJoinItem]
+	// Right record counts, indexed by key.
+	rightCounts *tbtree.Generic[*semiJoinRightItem]
+	// Right record counts in total, and the ones with a NULL key.
+	rightCount, rightNullCount int
+}
+
+func (s *SemiJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	state := &semiJoinState{
+		leftRecords: tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
+			return CompareValueSlices(a.GroupKey, b.GroupKey)
+		}, tbtree.Options{
+			NoLocks: true,
+		}),
+		rightCounts: tbtree.NewGenericOptions[*semiJoinRightItem](func(a, b *semiJoinRightItem) bool {
+			return CompareValueSlices(a.GroupKey, b.GroupKey)
+		}, tbtree.Options{
+			NoLocks: true,
+		}),
+	}
+
+	leftRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
+	rightRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
+
+	receiveRecord := func(input int, record Record) error {
+		if input == 0 {
+			return s.receiveLeftRecord(ctx, produce, state, record)
+		}
+		return s.receiveRightRecord(ctx, produce, state, record)
+	}
+
+	return runTwoInputs(ctx, s.left, s.right, "semi join", s.watermarkIdleTimeout, metaSend, twoInputHandlers{
+		records: func(input int, records []Record) error {
+			recordBuffer := leftRecordBuffer
+			if input == 1 {
+				recordBuffer = rightRecordBuffer
+			}
+			for _, record := range records {
+				if record.EventTime.IsZero() {
+					// If the event time is zero, don't buffer, there's no point.
+					// There won't be any record with an event time less than zero.
+					if err := receiveRecord(input, record); err != nil {
+						return fmt.Errorf("couldn't process record: %w", err)
+					}
+				} else if err := recordBuffer.AddRecord(record); err != nil {
+					return fmt.Errorf("couldn't buffer record: %w", err)
+				}
+			}
+			return nil
+		},
+		watermark: func(watermark time.Time) error {
+			if err := leftRecordBuffer.Emit(watermark, func(record Record) error {
+				if err := receiveRecord(0, record); err != nil {
+					return fmt.Errorf("couldn't process record from left: %w", err)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			if err := rightRecordBuffer.Emit(watermark, func(record Record) error {
+				if err := receiveRecord(1, record); err != nil {
+					return fmt.Errorf("couldn't process record from right: %w", err)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	})
+}
+
+func (s *SemiJoin) receiveLeftRecord(ctx ExecutionContext, produce ProduceFn, state *semiJoinState, record Record) error {
+	key, err := evaluateSemiJoinKey(ctx.WithRecord(record), s.keyExprsLeft)
+	if err != nil {
+		return err
+	}
+
+	itemTyped, ok := state.leftRecords.Get(&streamJoinItem{GroupKey: key})
+	if !ok {
+		itemTyped = &streamJoinItem{GroupKey: key, values: tbtree.NewGenericOptions(func(a, b *streamJoinSubitem) bool {
+			return CompareValueSlices(a.GroupKey, b.GroupKey)
+		}, tbtree.Options{NoLocks: true})}
+		state.leftRecords.Set(itemTyped)
+	}
+
+	subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
+	if !ok {
+		if record.Retraction {
+			return fmt.Errorf("semi join received retraction of record which wasn't received before")
+		}
+		subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
+		itemTyped.values.Set(subitemTyped)
+	}
+	if !record.Retraction {
+		subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
+	} else {
+		// TODO: This should delete the matching event time.
+		subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
+	}
+	if len(subitemTyped.EventTimes) == 0 {
+		itemTyped.values.Delete(subitemTyped)
+	}
+	if itemTyped.values.Len() == 0 {
+		state.leftRecords.Delete(itemTyped)
+	}
+
+	if !s.passes(key, state.rightCount, state.rightNullCount, state.rightKeyCount(key)) {
+		return nil
+	}
+	if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+		return fmt.Errorf("couldn't produce: %w", err)
+	}
+	return nil
+}
+
+func (s *SemiJoin) receiveRightRecord(ctx ExecutionContext, produce ProduceFn, state *semiJoinState, record Record) error {
+	key, err := evaluateSemiJoinKey(ctx.WithRecord(record), s.keyExprsRight)
+	if err != nil {
+		return err
+	}
+
+	diff := 1
+	if record.Retraction {
+		diff = -1
+	}
+
+	oldRightCount, oldRightNullCount := state.rightCount, state.rightNullCount
+	state.rightCount += diff
+	if hasNullValue(key) {
+		state.rightNullCount += diff
+	} else {
+		itemTyped, ok := state.rightCounts.Get(&semiJoinRightItem{GroupKey: key})
+		if !ok {
+			itemTyped = &semiJoinRightItem{GroupKey: key}
+			state.rightCounts.Set(itemTyped)
+		}
+		itemTyped.Count += diff
+		if itemTyped.Count == 0 {
+			state.rightCounts.Delete(itemTyped)
+		}
+	}
+	if state.rightCount < 0 {
+		return fmt.Errorf("semi join received retraction of record which wasn't received before")
+	}
+
+	// Emits the changes of the left records with the given key caused by this right record.
+	update := func(leftItem *streamJoinItem) error {
+		newRightKeyCount := state.rightKeyCount(leftItem.GroupKey)
+		oldRightKeyCount := newRightKeyCount
+		if !hasNullValue(key) && valueSlicesEqual(leftItem.GroupKey, key) {
+			// This record changed the count for this key.
+			oldRightKeyCount -= diff
+		}
+
+		oldPasses := s.passes(leftItem.GroupKey, oldRightCount, oldRightNullCount, oldRightKeyCount)
+		newPasses := s.passes(leftItem.GroupKey, state.rightCount, state.rightNullCount, newRightKeyCount)
+		if oldPasses == newPasses {
+			return nil
+		}
+
+		var outErr error
+		leftItem.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
+			for i := range subitemTyped.EventTimes {
+				if err := produce(ProduceFromExecutionContext(ctx), NewRecord(subitemTyped.GroupKey, oldPasses, subitemTyped.EventTimes[i])); err != nil {
+					outErr = fmt.Errorf("couldn't produce: %w", err)
+					return false
+				}
+			}
+			return true
+		})
+		return outErr
+	}
+
+	if s.isAnti && ((oldRightCount == 0) != (state.rightCount == 0) || (oldRightNullCount == 0) != (state.rightNullCount == 0)) {
+		// The right side became empty, stopped being empty, or the presence of NULL changed, which may affect any left record.
+		var outErr error
+		state.leftRecords.Scan(func(leftItem *streamJoinItem) bool {
+			if err := update(leftItem); err != nil {
+				outErr = err
+				return false
+			}
+			return true
+		})
+		return outErr
+	}
+	if hasNullValue(key) {
+		return nil
+	}
+	leftItem, ok := state.leftRecords.Get(&streamJoinItem{GroupKey: key})
+	if !ok {
+		return nil
+	}
+	return update(leftItem)
+}
+
+func (s *SemiJoin) passes(key GroupKey, rightCount, rightNullCount, rightKeyCount int) bool {
+	if !s.isAnti {
+		return !hasNullValue(key) && rightKeyCount > 0
+	}
+	return rightCount == 0 || (!hasNullValue(key) && rightNullCount == 0 && rightKeyCount == 0)
+}
+
+func (state *semiJoinState) rightKeyCount(key GroupKey) int {
+	if hasNullValue(key) {
+		return 0
+	}
+	itemTyped, ok := state.rightCounts.Get(&semiJoinRightItem{GroupKey: key})
+	if !ok {
+		return 0
+	}
+	return itemTyped.Count
+}
+
+func evaluateSemiJoinKey(ctx ExecutionContext, keyExprs []Expression) (GroupKey, error) {
+	key := make(GroupKey, len(keyExprs))
+	for i, expr := range keyExprs {
+		value, err := expr.Evaluate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't evaluate %d semi join key expression: %w", i, err)
+		}
+		key[i] = value
+	}
+	return key, nil
+}
+
+func hasNullValue(values []octosql.Value) bool {
+	for i := range values {
+		if values[i].TypeID == octosql.TypeIDNull {
+			return true
+		}
+	}
+	return false
+}
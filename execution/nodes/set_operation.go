@@ -0,0 +1,111 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type SetOperationType int
+
+const (
+	// SetOperationTypeIntersectAll outputs each record as many times as it's present in both sources.
+	SetOperationTypeIntersectAll SetOperationType = iota
+	// SetOperationTypeExceptAll outputs each record as many times as it's present in the left source more than in the right one.
+	SetOperationTypeExceptAll
+)
+
+// SetOperation tracks the count of each record in both sources and emits the changes of the resulting counts.
+type SetOperation struct {
+	left, right   Node
+	operationType SetOperationType
+	// Fixes the layout of object fields, which may differ between both sources.
+	fieldLayoutFixers    []*ObjectLayoutFixer
+	watermarkIdleTimeout time.Duration
+}
+
+func NewSetOperation(left, right Node, operationType SetOperationType, fieldLayoutFixers []*ObjectLayoutFixer, watermarkIdleTimeout time.Duration) *SetOperation {
+	return &SetOperation{
+		left:                 left,
+		right:                right,
+		operationType:        operationType,
+		fieldLayoutFixers:    fieldLayoutFixers,
+		watermarkIdleTimeout: watermarkIdleTimeout,
+	}
+}
+
+type setOperationItem struct {
+	Values                []octosql.Value
+	LeftCount, RightCount int
+}
+
+func (s *SetOperation) outputCount(item *setOperationItem) int {
+	switch s.operationType {
+	case SetOperationTypeIntersectAll:
+		if item.LeftCount < item.RightCount {
+			return item.LeftCount
+		}
+		return item.RightCount
+	case SetOperationTypeExceptAll:
+		if item.LeftCount > item.RightCount {
+			return item.LeftCount - item.RightCount
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("invalid set operation type: %d", s.operationType))
+}
+
+func (s *SetOperation) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	recordCounts := btree.NewGenericOptions(func(item, than *setOperationItem) bool {
+		return CompareValueSlices(item.Values, than.Values)
+	}, btree.Options{
+		NoLocks: true,
+	})
+
+	return runTwoInputs(ctx, s.left, s.right, "set operation", s.watermarkIdleTimeout, metaSend, twoInputHandlers{
+		records: func(input int, records []Record) error {
+			for _, record := range records {
+				values := record.Values
+				for i := range values {
+					values[i] = s.fieldLayoutFixers[i].FixLayout(input, values[i])
+				}
+
+				item, ok := recordCounts.Get(&setOperationItem{Values: values})
+				if !ok {
+					item = &setOperationItem{Values: values}
+					recordCounts.Set(item)
+				}
+				diff := 1
+				if record.Retraction {
+					diff = -1
+				}
+
+				oldOutputCount := s.outputCount(item)
+				if input == 0 {
+					item.LeftCount += diff
+				} else {
+					item.RightCount += diff
+				}
+				if item.LeftCount < 0 || item.RightCount < 0 {
+					return fmt.Errorf("set operation received retraction of record which wasn't received before")
+				}
+				newOutputCount := s.outputCount(item)
+				if item.LeftCount == 0 && item.RightCount == 0 {
+					recordCounts.Delete(item)
+				}
+
+				// The output count changes by at most one.
+				if newOutputCount != oldOutputCount {
+					if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, newOutputCount < oldOutputCount, record.EventTime)); err != nil {
+						return fmt.Errorf("couldn't produce record: %w", err)
+					}
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/btree"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/spill"
 	"github.com/cube2222/octosql/octosql"
 )
 
@@ -15,27 +16,45 @@ type SimpleGroupBy struct {
 	aggregatePrototypes []func() Aggregate
 	aggregateExprs      []Expression
 	keyExprs            []Expression
-	source              Node
+	groupingSets        [][]int
+	// Once the aggregates take up half of the memory budget, the inputs for all keys not in memory get spilled to disk,
+	// and are aggregated when producing the results. Disabled if zero.
+	memoryBudget int
+	source       Node
 }
 
 func NewSimpleGroupBy(
 	aggregatePrototypes []func() Aggregate,
 	aggregateExprs []Expression,
 	keyExprs []Expression,
+	groupingSets [][]int,
+	memoryBudget int,
 	source Node,
 ) *SimpleGroupBy {
 	return &SimpleGroupBy{
 		aggregatePrototypes: aggregatePrototypes,
 		aggregateExprs:      aggregateExprs,
 		keyExprs:            keyExprs,
+		groupingSets:        groupingSets,
+		memoryBudget:        memoryBudget,
 		source:              source,
 	}
 }
 
+// aggregateStateSize is a rough estimate of the memory used by a single aggregate.
+const aggregateStateSize = 64
+
 func (g *SimpleGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
 	aggregates := btree.NewG[*aggregatesItem](BTreeDefaultDegree, func(a, b *aggregatesItem) bool {
 		return CompareValueSlices(a.GroupKey, b.GroupKey)
 	})
+	stateSize := 0
+	// Once full, no keys are added to the in-memory state anymore, so that the inputs of each key get aggregated in their original order.
+	full := false
+	spilled := spill.NewSorter(g.memoryBudget/2, func(a, b *spill.Entry) bool {
+		return CompareValueSlices(a.Key, b.Key)
+	})
+	defer spilled.Close()
 
 	if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
 		ctx := ctx.WithRecord(record)
@@ -49,42 +68,43 @@ func (g *SimpleGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend Me
 			key[i] = value
 		}
 
-		{
+		aggregateInputs := make([]octosql.Value, len(g.aggregateExprs))
+		for i, expr := range g.aggregateExprs {
+			value, err := expr.Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
+			}
+			aggregateInputs[i] = value
+		}
+
+		for _, key := range groupingSetKeys(key, g.groupingSets) {
 			itemTyped, ok := aggregates.Get(&aggregatesItem{GroupKey: key})
 
 			if !ok {
-				newAggregates := make([]Aggregate, len(g.aggregatePrototypes))
-				for i := range g.aggregatePrototypes {
-					newAggregates[i] = g.aggregatePrototypes[i]()
+				if g.memoryBudget > 0 && stateSize >= g.memoryBudget/2 {
+					full = true
+				}
+				if full {
+					count := 1
+					if record.Retraction {
+						count = -1
+					}
+					if err := spilled.Add(spill.Entry{Key: key, Values: aggregateInputs, Count: count}); err != nil {
+						return fmt.Errorf("couldn't spill aggregate inputs: %w", err)
+					}
+					continue
 				}
 
-				itemTyped = &aggregatesItem{GroupKey: key, Aggregates: newAggregates, AggregatedSetSize: make([]int, len(g.aggregatePrototypes))}
+				itemTyped = newAggregatesItem(key, g.aggregatePrototypes)
 				aggregates.ReplaceOrInsert(itemTyped)
+				stateSize += spill.Size(key) + len(g.aggregatePrototypes)*aggregateStateSize
 			}
 
-			if !record.Retraction {
-				itemTyped.OverallRecordCount++
-			} else {
-				itemTyped.OverallRecordCount--
-			}
-			for i, expr := range g.aggregateExprs {
-				aggregateInput, err := expr.Evaluate(ctx)
-				if err != nil {
-					return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
-				}
-
-				if aggregateInput.TypeID != octosql.TypeIDNull {
-					if !record.Retraction {
-						itemTyped.AggregatedSetSize[i]++
-					} else {
-						itemTyped.AggregatedSetSize[i]--
-					}
-					itemTyped.Aggregates[i].Add(record.Retraction, aggregateInput)
-				}
-			}
+			itemTyped.add(record.Retraction, aggregateInputs)
 
 			if itemTyped.OverallRecordCount == 0 {
 				aggregates.Delete(itemTyped)
+				stateSize -= spill.Size(key) + len(g.aggregatePrototypes)*aggregateStateSize
 			}
 		}
 
@@ -95,27 +115,105 @@ func (g *SimpleGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend Me
 		return fmt.Errorf("couldn't run source: %w", err)
 	}
 
-	var err error
-	aggregates.Ascend(func(itemTyped *aggregatesItem) bool {
-		key := itemTyped.GroupKey
+	// The spilled inputs are merged with the in-memory aggregates, both are ordered by key.
+	spilledInputs, err := spilled.Merge()
+	if err != nil {
+		return fmt.Errorf("couldn't merge spilled aggregate inputs: %w", err)
+	}
+	defer spilledInputs.Close()
+
+	addSpilledInputs := func(item *aggregatesItem) error {
+		for {
+			entry, ok := spilledInputs.Peek()
+			if !ok || CompareValueSlices(item.GroupKey, entry.Key) {
+				return nil
+			}
+			next, _, err := spilledInputs.Next()
+			if err != nil {
+				return fmt.Errorf("couldn't read spilled aggregate inputs: %w", err)
+			}
+			item.add(next.Count < 0, next.Values)
+		}
+	}
+	produceItem := func(item *aggregatesItem) error {
+		if item.OverallRecordCount == 0 {
+			return nil
+		}
+		key := item.GroupKey
 
 		outputValues := make([]octosql.Value, len(key)+len(g.aggregateExprs))
 		copy(outputValues, key)
 
-		for i := range itemTyped.Aggregates {
-			if itemTyped.AggregatedSetSize[i] > 0 {
-				outputValues[len(key)+i] = itemTyped.Aggregates[i].Trigger()
+		for i := range item.Aggregates {
+			if item.AggregatedSetSize[i] > 0 {
+				outputValues[len(key)+i] = item.Aggregates[i].Trigger()
 			} else {
 				outputValues[len(key)+i] = octosql.NewNull()
 			}
 		}
 
-		if err = produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, false, time.Time{})); err != nil {
+		return produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, false, time.Time{}))
+	}
+	// produceSpilledKeys produces the keys which are only present in the spilled inputs, up to the given key.
+	produceSpilledKeys := func(upTo GroupKey) error {
+		for {
+			entry, ok := spilledInputs.Peek()
+			if !ok || (upTo != nil && !CompareValueSlices(entry.Key, upTo)) {
+				return nil
+			}
+			item := newAggregatesItem(entry.Key, g.aggregatePrototypes)
+			if err := addSpilledInputs(item); err != nil {
+				return err
+			}
+			if err := produceItem(item); err != nil {
+				return err
+			}
+		}
+	}
+
+	aggregates.Ascend(func(itemTyped *aggregatesItem) bool {
+		if err = produceSpilledKeys(itemTyped.GroupKey); err != nil {
+			return false
+		}
+		if err = addSpilledInputs(itemTyped); err != nil {
+			return false
+		}
+		if err = produceItem(itemTyped); err != nil {
 			return false
 		}
 
 		return true
 	})
+	if err != nil {
+		return err
+	}
+
+	return produceSpilledKeys(nil)
+}
+
+func newAggregatesItem(key GroupKey, aggregatePrototypes []func() Aggregate) *aggregatesItem {
+	newAggregates := make([]Aggregate, len(aggregatePrototypes))
+	for i := range aggregatePrototypes {
+		newAggregates[i] = aggregatePrototypes[i]()
+	}
+
+	return &aggregatesItem{GroupKey: key, Aggregates: newAggregates, AggregatedSetSize: make([]int, len(aggregatePrototypes))}
+}
 
-	return err
+func (item *aggregatesItem) add(retraction bool, aggregateInputs []octosql.Value) {
+	if !retraction {
+		item.OverallRecordCount++
+	} else {
+		item.OverallRecordCount--
+	}
+	for i, aggregateInput := range aggregateInputs {
+		if aggregateInput.TypeID != octosql.TypeIDNull {
+			if !retraction {
+				item.AggregatedSetSize[i]++
+			} else {
+				item.AggregatedSetSize[i]--
+			}
+			item.Aggregates[i].Add(retraction, aggregateInput)
+		}
+	}
 }
@@ -13,14 +13,26 @@ import (
 type StreamJoin struct {
 	left, right                 Node
 	keyExprsLeft, keyExprsRight []Expression
+	// If timeBound is set, only records satisfying it are joined,
+	// and records which can't be joined with any future records get evicted from the join state.
+	timeBound *JoinTimeBound
+	// Records are kept in the join state for the allowed lateness longer, so that late records can still be joined with them.
+	lateRecords *LateRecords
+	// Both sides get half of the memory budget, see joinState. Disabled if zero.
+	memoryBudget         int
+	watermarkIdleTimeout time.Duration
 }
 
-func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *StreamJoin {
+func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, timeBound *JoinTimeBound, lateRecords *LateRecords, memoryBudget int, watermarkIdleTimeout time.Duration) *StreamJoin {
 	return &StreamJoin{
-		left:          left,
-		right:         right,
-		keyExprsLeft:  keyExprsLeft,
-		keyExprsRight: keyExprsRight,
+		left:                 left,
+		right:                right,
+		keyExprsLeft:         keyExprsLeft,
+		keyExprsRight:        keyExprsRight,
+		timeBound:            timeBound,
+		lateRecords:          lateRecords,
+		memoryBudget:         memoryBudget,
+		watermarkIdleTimeout: watermarkIdleTimeout,
 	}
 }
 
@@ -35,6 +47,8 @@ type streamJoinSubitem struct {
 	GroupKey
 	// Record event times
 	EventTimes []time.Time
+	// Count of matching records on the other side, only tracked by time bounded outer joins.
+	Matches int
 }
 
 func (s *StreamJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
@@ -96,28 +110,34 @@ func (s *StreamJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 		close(rightMessages)
 	}()
 
-	leftRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
-		return CompareValueSlices(a.GroupKey, b.GroupKey)
-	}, tbtree.Options{
-		NoLocks: true,
-	})
-	rightRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
-		return CompareValueSlices(a.GroupKey, b.GroupKey)
-	}, tbtree.Options{
-		NoLocks: true,
-	})
+	leftRecords := newJoinState(s.memoryBudget / 2)
+	defer leftRecords.close()
+	rightRecords := newJoinState(s.memoryBudget / 2)
+	defer rightRecords.close()
+
+	var leftEvictionQueue, rightEvictionQueue *joinEvictionQueue
+	if s.timeBound != nil {
+		if s.timeBound.Lower != nil {
+			leftEvictionQueue = newJoinEvictionQueue(s.timeBound.LeftTimeFieldIndex)
+		}
+		if s.timeBound.Upper != nil {
+			rightEvictionQueue = newJoinEvictionQueue(s.timeBound.RightTimeFieldIndex)
+		}
+	}
 
 	var leftDone bool
 
-	var leftWatermark, rightWatermark, minWatermark time.Time
+	watermarks := newInputWatermarks(2, s.watermarkIdleTimeout)
+	defer watermarks.stop()
+	var minWatermark time.Time
 
-	leftRecordBuffer := NewRecordEventTimeBuffer()
-	rightRecordBuffer := NewRecordEventTimeBuffer()
+	leftRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
+	rightRecordBuffer := NewRecordEventTimeBuffer(s.lateRecords)
 
 	processRecordsUpTo := func(ctx ExecutionContext, watermark time.Time, oneStreamRemains bool) error {
 		if rightRecords != nil {
 			if err := leftRecordBuffer.Emit(watermark, func(record Record) error {
-				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, true, record, oneStreamRemains); err != nil {
+				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, leftEvictionQueue, true, record, oneStreamRemains); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from left: %w", err)
 				}
@@ -130,7 +150,7 @@ func (s *StreamJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 
 		if leftRecords != nil {
 			if err := rightRecordBuffer.Emit(watermark, func(record Record) error {
-				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, false, record, oneStreamRemains); err != nil {
+				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, rightEvictionQueue, false, record, oneStreamRemains); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from right: %w", err)
 				}
@@ -144,6 +164,47 @@ func (s *StreamJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 		return nil
 	}
 
+	evictRecordsUpTo := func(watermark time.Time) error {
+		watermark = watermark.Add(-s.lateRecords.AllowedLateness())
+		if leftEvictionQueue != nil && leftRecords != nil {
+			threshold, _ := s.timeBound.evictionThreshold(true, watermark)
+			if err := leftEvictionQueue.evict(leftRecords, threshold, false, nil); err != nil {
+				return err
+			}
+		}
+		if rightEvictionQueue != nil && rightRecords != nil {
+			threshold, _ := s.timeBound.evictionThreshold(false, watermark)
+			if err := rightEvictionQueue.evict(rightRecords, threshold, false, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// advanceWatermark processes the buffered records and sends the watermark, if the minimum watermark of the inputs has risen.
+	advanceWatermark := func() error {
+		min := watermarks.min()
+		if !min.After(minWatermark) {
+			return nil
+		}
+		minWatermark = min
+
+		if err := processRecordsUpTo(ctx, minWatermark, false); err != nil {
+			return err
+		}
+		if err := evictRecordsUpTo(minWatermark); err != nil {
+			return err
+		}
+
+		if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+			Type:      MetadataMessageTypeWatermark,
+			Watermark: minWatermark,
+		}); err != nil {
+			return fmt.Errorf("couldn't send metadata: %w", err)
+		}
+		return nil
+	}
+
 receiveLoop:
 	for {
 		select {
@@ -155,41 +216,25 @@ receiveLoop:
 			if msg.err != nil {
 				return msg.err
 			}
+			watermarks.received(0)
 			if msg.metadata {
-				leftWatermark = msg.metadataMessage.Watermark
-
-				var min time.Time
-				if leftWatermark.After(rightWatermark) {
-					min = rightWatermark
-				} else {
-					min = leftWatermark
-				}
-				if min.After(minWatermark) {
-					minWatermark = min
-
-					if err := processRecordsUpTo(ctx, minWatermark, false); err != nil {
-						return err
-					}
-
-					if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
-						Type:      MetadataMessageTypeWatermark,
-						Watermark: minWatermark,
-					}); err != nil {
-						return fmt.Errorf("couldn't send metadata: %w", err)
-					}
+				watermarks.setWatermark(0, msg.metadataMessage.Watermark)
+				if err := advanceWatermark(); err != nil {
+					return err
 				}
-
 				continue
 			}
 			if msg.record.EventTime.IsZero() {
 				// If the event time is zero, don't buffer, there's no point.
 				// There won't be any record with an event time less than zero.
-				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, true, msg.record, false); err != nil {
+				if err := s.receiveRecord(ctx, produce, leftRecords, rightRecords, leftEvictionQueue, true, msg.record, false); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from left: %w", err)
 				}
 			} else {
-				leftRecordBuffer.AddRecord(msg.record)
+				if err := leftRecordBuffer.AddRecord(msg.record); err != nil {
+					return fmt.Errorf("couldn't buffer record: %w", err)
+				}
 			}
 			// TODO: Add backpressure
 
@@ -201,69 +246,70 @@ receiveLoop:
 			if msg.err != nil {
 				return msg.err
 			}
+			watermarks.received(1)
 			if msg.metadata {
-				rightWatermark = msg.metadataMessage.Watermark
-
-				var min time.Time
-				if rightWatermark.After(leftWatermark) {
-					min = leftWatermark
-				} else {
-					min = rightWatermark
+				watermarks.setWatermark(1, msg.metadataMessage.Watermark)
+				if err := advanceWatermark(); err != nil {
+					return err
 				}
-				if min.After(minWatermark) {
-					minWatermark = min
-
-					if err := processRecordsUpTo(ctx, minWatermark, false); err != nil {
-						return err
-					}
-
-					if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
-						Type:      MetadataMessageTypeWatermark,
-						Watermark: minWatermark,
-					}); err != nil {
-						return fmt.Errorf("couldn't send metadata: %w", err)
-					}
-				}
-
 				continue
 			}
 			if msg.record.EventTime.IsZero() {
 				// If the event time is zero, don't buffer, there's no point.
 				// There won't be any record with an event time less than zero.
-				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, false, msg.record, false); err != nil {
+				if err := s.receiveRecord(ctx, produce, rightRecords, leftRecords, rightEvictionQueue, false, msg.record, false); err != nil {
 					// TODO: Fix goroutine leak.
 					return fmt.Errorf("couldn't process record from right: %w", err)
 				}
 			} else {
-				rightRecordBuffer.AddRecord(msg.record)
+				if err := rightRecordBuffer.AddRecord(msg.record); err != nil {
+					return fmt.Errorf("couldn't buffer record: %w", err)
+				}
 			}
 			// TODO: Add backpressure
+
+		case <-watermarks.idleCheck():
+			watermarks.markIdle()
+			if err := advanceWatermark(); err != nil {
+				return err
+			}
 		}
 	}
 
 	var openChannel chan chanMessage
 	var myRecordBuffer, otherRecordBuffer *RecordEventTimeBuffer
-	var myRecords, otherRecords *tbtree.Generic[*streamJoinItem]
+	var myRecords, otherRecords *joinState
+	var myEvictionQueue *joinEvictionQueue
+	var myWatermark time.Time
 	oneStreamRemains := false
 	if !leftDone {
 		openChannel = leftMessages
 		myRecords = leftRecords
 		myRecordBuffer = leftRecordBuffer
-		minWatermark = leftWatermark
+		myEvictionQueue = leftEvictionQueue
+		myWatermark = watermarks.watermark(0)
 		otherRecords = rightRecords
 		otherRecordBuffer = rightRecordBuffer
 	} else {
 		openChannel = rightMessages
 		myRecords = rightRecords
 		myRecordBuffer = rightRecordBuffer
-		minWatermark = rightWatermark
+		myEvictionQueue = rightEvictionQueue
+		myWatermark = watermarks.watermark(1)
 		otherRecords = leftRecords
 		otherRecordBuffer = leftRecordBuffer
 	}
+	// If the remaining input has been idle, it may be behind the watermark which has already been sent.
+	if myWatermark.After(minWatermark) {
+		minWatermark = myWatermark
+	}
 
 	if err := processRecordsUpTo(ctx, minWatermark, true); err != nil {
 		return err
 	}
+	if err := evictRecordsUpTo(minWatermark); err != nil {
+		return err
+	}
 
 	markOneStreamRemains := func() {
 		oneStreamRemains = true
@@ -285,9 +331,17 @@ receiveLoop:
 			return msg.err
 		}
 		if msg.metadata {
+			if !msg.metadataMessage.Watermark.After(minWatermark) {
+				continue
+			}
+			minWatermark = msg.metadataMessage.Watermark
+
 			if err := processRecordsUpTo(ctx, msg.metadataMessage.Watermark, oneStreamRemains); err != nil {
 				return err
 			}
+			if err := evictRecordsUpTo(msg.metadataMessage.Watermark); err != nil {
+				return err
+			}
 
 			if otherRecordBuffer.Empty() {
 				markOneStreamRemains()
@@ -302,11 +356,13 @@ receiveLoop:
 		if msg.record.EventTime.IsZero() {
 			// If the event time is zero, don't buffer, there's no point.
 			// There won't be any record with an event time less than zero.
-			if err := s.receiveRecord(ctx, produce, myRecords, otherRecords, !leftDone, msg.record, oneStreamRemains); err != nil {
+			if err := s.receiveRecord(ctx, produce, myRecords, otherRecords, myEvictionQueue, !leftDone, msg.record, oneStreamRemains); err != nil {
 				return fmt.Errorf("couldn't process record: %w", err)
 			}
 		} else {
-			myRecordBuffer.AddRecord(msg.record)
+			if err := myRecordBuffer.AddRecord(msg.record); err != nil {
+				return fmt.Errorf("couldn't buffer record: %w", err)
+			}
 		}
 	}
 
@@ -317,7 +373,7 @@ receiveLoop:
 	return nil
 }
 
-func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *tbtree.Generic[*streamJoinItem], amLeft bool, record Record, oneStreamRemains bool) error {
+func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *joinState, myEvictionQueue *joinEvictionQueue, amLeft bool, record Record, oneStreamRemains bool) error {
 	ctx = ctx.WithRecord(record)
 
 	var keyExprs []Expression
@@ -337,43 +393,34 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 	}
 
 	if !oneStreamRemains {
-		// Update count in my record tree
-		// If only one stream remains, we won't be using it anymore, so we don't need to update it.
-		itemTyped, ok := myRecords.Get(&streamJoinItem{GroupKey: key})
-
-		if !ok {
-			itemTyped = &streamJoinItem{GroupKey: key, values: tbtree.NewGenericOptions(func(a, b *streamJoinSubitem) bool {
-				return CompareValueSlices(a.GroupKey, b.GroupKey)
-			}, tbtree.Options{NoLocks: true})}
-			myRecords.Set(itemTyped)
+		isRetractionOfEvictedRecord, err := s.isRetractionOfEvictedRecord(myRecords, key, record)
+		if err != nil {
+			return err
 		}
-
-		{
-			subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
-
-			if !ok {
-				subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
-				itemTyped.values.Set(subitemTyped)
-			}
-			if !record.Retraction {
-				subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
-			} else {
-				// TODO: This should delete the matching event time.
-				subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
+		if !isRetractionOfEvictedRecord {
+			// Update count in my record tree
+			// If only one stream remains, we won't be using it anymore, so we don't need to update it.
+			update, err := myRecords.update(key, record)
+			if err != nil {
+				return err
 			}
-			if len(subitemTyped.EventTimes) == 0 {
-				itemTyped.values.Delete(subitemTyped)
+			if myEvictionQueue != nil {
+				if update.newSubitem {
+					myEvictionQueue.add(key, record.Values)
+				}
+				if update.removedSubitem {
+					myEvictionQueue.remove(key, record.Values)
+				}
 			}
 		}
-
-		if itemTyped.values.Len() == 0 {
-			myRecords.Delete(itemTyped)
-		}
 	}
 
 	// Trigger with all matching records from other record tree
 	{
-		itemTyped, ok := otherRecords.Get(&streamJoinItem{GroupKey: key})
+		itemTyped, ok, err := otherRecords.get(key)
+		if err != nil {
+			return err
+		}
 
 		if !ok {
 			// Nothing to trigger
@@ -382,6 +429,9 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 
 		var outErr error
 		itemTyped.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
+			if s.timeBound != nil && !timeBoundMatches(s.timeBound, amLeft, record.Values, subitemTyped.GroupKey) {
+				return true
+			}
 			for i := 0; i < len(subitemTyped.EventTimes); i++ {
 				outputValues := make([]octosql.Value, len(record.Values)+len(subitemTyped.GroupKey))
 
@@ -415,3 +465,16 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 
 	return nil
 }
+
+// isRetractionOfEvictedRecord checks if the record is a retraction of a record which has already been evicted from the time bounded join state.
+// Such a retraction is still joined with the remaining records of the other side.
+func (s *StreamJoin) isRetractionOfEvictedRecord(myRecords *joinState, key GroupKey, record Record) (bool, error) {
+	if s.timeBound == nil || !record.Retraction {
+		return false, nil
+	}
+	contains, err := myRecords.contains(key, record.Values)
+	if err != nil {
+		return false, err
+	}
+	return !contains, nil
+}
@@ -0,0 +1,137 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// twoInputHandlers receive the merged messages of the inputs of a node with two inputs.
+// Input 0 is the left one, and input 1 is the right one.
+type twoInputHandlers struct {
+	// records receives the records of an input, in the order they were sent. Their values may be kept.
+	records func(input int, records []Record) error
+	// finished is called when an input finishes. Optional.
+	finished func(input int) error
+	// watermark is called when the minimum watermark of the inputs rises, before it's sent on. Optional.
+	// It's called with WatermarkMaxValue once both inputs finish, which doesn't get sent on.
+	watermark func(watermark time.Time) error
+}
+
+type twoInputsMessage struct {
+	metadata        bool
+	metadataMessage MetadataMessage
+	records         []Record
+	err             error
+}
+
+// runTwoInputs runs both inputs in separate goroutines and passes their messages to the handlers in this one,
+// sending on the minimum watermark of the inputs.
+// The input goroutines get stopped when it returns.
+func runTwoInputs(ctx ExecutionContext, left, right Node, nodeName string, watermarkIdleTimeout time.Duration, metaSend MetaSendFn, handlers twoInputHandlers) error {
+	runCtx, cancel := context.WithCancel(ctx.Context)
+	defer cancel()
+	ctx = ExecutionContext{Context: runCtx, VariableContext: ctx.VariableContext}
+
+	runSource := func(source Node, messages chan<- twoInputsMessage, inputName string) {
+		defer close(messages)
+
+		send := func(msg twoInputsMessage) error {
+			select {
+			case messages <- msg:
+				return nil
+			case <-runCtx.Done():
+				return runCtx.Err()
+			}
+		}
+		if err := RunBatch(source, ctx, func(produceCtx ProduceContext, batch *RecordBatch) error {
+			// The batch gets reused, so the records have to be copied.
+			records := make([]Record, len(batch.Records))
+			for i, record := range batch.Records {
+				values := make([]octosql.Value, len(record.Values))
+				copy(values, record.Values)
+				records[i] = NewRecord(values, record.Retraction, record.EventTime)
+			}
+			return send(twoInputsMessage{records: records})
+		}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+			return send(twoInputsMessage{metadata: true, metadataMessage: msg})
+		}); err != nil && runCtx.Err() == nil {
+			send(twoInputsMessage{err: fmt.Errorf("couldn't run %s %s source: %w", inputName, nodeName, err)})
+		}
+	}
+
+	leftMessages := make(chan twoInputsMessage, 64)
+	rightMessages := make(chan twoInputsMessage, 64)
+	go runSource(left, leftMessages, "left")
+	go runSource(right, rightMessages, "right")
+
+	watermarks := newInputWatermarks(2, watermarkIdleTimeout)
+	defer watermarks.stop()
+	var minWatermark time.Time
+
+	for leftMessages != nil || rightMessages != nil {
+		var msg twoInputsMessage
+		var ok, closed bool
+		var input int
+		select {
+		case msg, ok = <-leftMessages:
+			input, closed = 0, !ok
+			if closed {
+				leftMessages = nil
+			}
+		case msg, ok = <-rightMessages:
+			input, closed = 1, !ok
+			if closed {
+				rightMessages = nil
+			}
+		case <-watermarks.idleCheck():
+			watermarks.markIdle()
+		}
+
+		switch {
+		case closed:
+			watermarks.finished(input)
+			if handlers.finished != nil {
+				if err := handlers.finished(input); err != nil {
+					return err
+				}
+			}
+		case ok && msg.err != nil:
+			return msg.err
+		case ok && !msg.metadata:
+			watermarks.received(input)
+			if err := handlers.records(input, msg.records); err != nil {
+				return err
+			}
+			continue
+		case ok:
+			watermarks.received(input)
+			watermarks.setWatermark(input, msg.metadataMessage.Watermark)
+		}
+
+		min := watermarks.min()
+		if !min.After(minWatermark) {
+			continue
+		}
+		minWatermark = min
+
+		if handlers.watermark != nil {
+			if err := handlers.watermark(minWatermark); err != nil {
+				return err
+			}
+		}
+		if minWatermark != WatermarkMaxValue {
+			if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+type UnionAll struct {
+	left, right Node
+	// Fixes the layout of object fields, which may differ between both sources.
+	fieldLayoutFixers    []*ObjectLayoutFixer
+	watermarkIdleTimeout time.Duration
+}
+
+func NewUnionAll(left, right Node, fieldLayoutFixers []*ObjectLayoutFixer, watermarkIdleTimeout time.Duration) *UnionAll {
+	return &UnionAll{
+		left:                 left,
+		right:                right,
+		fieldLayoutFixers:    fieldLayoutFixers,
+		watermarkIdleTimeout: watermarkIdleTimeout,
+	}
+}
+
+func (u *UnionAll) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	return runTwoInputs(ctx, u.left, u.right, "union", u.watermarkIdleTimeout, metaSend, twoInputHandlers{
+		records: func(input int, records []Record) error {
+			for _, record := range records {
+				for i := range record.Values {
+					record.Values[i] = u.fieldLayoutFixers[i].FixLayout(input, record.Values[i])
+				}
+				if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+					return fmt.Errorf("couldn't produce record: %w", err)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,356 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type WindowFunctionType int
+
+const (
+	WindowFunctionTypeRowNumber WindowFunctionType = iota
+	WindowFunctionTypeRank
+	WindowFunctionTypeDenseRank
+	WindowFunctionTypeLag
+	WindowFunctionTypeLead
+	WindowFunctionTypeFirstValue
+	WindowFunctionTypeLastValue
+	WindowFunctionTypeAggregate
+)
+
+type WindowFunction struct {
+	Type      WindowFunctionType
+	Arguments []Expression
+	// AggregatePrototype is only set for aggregates used as window functions.
+	AggregatePrototype func() Aggregate
+}
+
+// Window computes window functions over partitions of its source, ordered by the order by key.
+// The frame of each row spans from the start of the partition up to the last row which is equal to it by the order by key.
+//
+// Partitions which got changed are recalculated on each watermark and at the end of the stream.
+// Rows whose window function values changed get their previous values retracted.
+type Window struct {
+	source                      Node
+	partitionByExprs            []Expression
+	orderByKeyExprs             []Expression
+	orderByDirectionMultipliers []int
+	functions                   []WindowFunction
+}
+
+func NewWindow(source Node, partitionByExprs []Expression, orderByKeyExprs []Expression, orderByDirectionMultipliers []int, functions []WindowFunction) *Window {
+	return &Window{
+		source:                      source,
+		partitionByExprs:            partitionByExprs,
+		orderByKeyExprs:             orderByKeyExprs,
+		orderByDirectionMultipliers: orderByDirectionMultipliers,
+		functions:                   functions,
+	}
+}
+
+type windowPartition struct {
+	GroupKey
+	Rows  *btree.BTree
+	Dirty bool
+}
+
+type windowRowItem struct {
+	Key                  []octosql.Value
+	Values               []octosql.Value
+	DirectionMultipliers []int
+
+	EventTime time.Time
+	Count     int
+	// Arguments holds the evaluated arguments of each window function.
+	Arguments [][]octosql.Value
+	// PreviouslySent holds the window function values last sent for each copy of this row.
+	PreviouslySent [][]octosql.Value
+}
+
+func (item *windowRowItem) Less(than btree.Item) bool {
+	thanTyped, ok := than.(*windowRowItem)
+	if !ok {
+		panic(fmt.Sprintf("invalid window row comparison: %T", than))
+	}
+
+	for i := 0; i < len(item.Key); i++ {
+		if comp := item.Key[i].Compare(thanTyped.Key[i]); comp != 0 {
+			return comp*item.DirectionMultipliers[i] == -1
+		}
+	}
+
+	// If keys are equal, differentiate by values.
+	return CompareValueSlices(item.Values, thanTyped.Values)
+}
+
+func (w *Window) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	partitions := btree.New(BTreeDefaultDegree)
+	var dirtyPartitions []*windowPartition
+
+	if err := w.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		ctx := ctx.WithRecord(record)
+
+		partitionKey := make(GroupKey, len(w.partitionByExprs))
+		for i, expr := range w.partitionByExprs {
+			value, err := expr.Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d partition by expression: %w", i, err)
+			}
+			partitionKey[i] = value
+		}
+
+		orderByKey := make([]octosql.Value, len(w.orderByKeyExprs))
+		for i, expr := range w.orderByKeyExprs {
+			value, err := expr.Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d order by expression: %w", i, err)
+			}
+			orderByKey[i] = value
+		}
+
+		var partition *windowPartition
+		if item := partitions.Get(partitionKey); item != nil {
+			var ok bool
+			partition, ok = item.(*windowPartition)
+			if !ok {
+				panic(fmt.Sprintf("invalid window partition item: %v", item))
+			}
+		} else {
+			partition = &windowPartition{GroupKey: partitionKey, Rows: btree.New(BTreeDefaultDegree)}
+			partitions.ReplaceOrInsert(partition)
+		}
+		if !partition.Dirty {
+			partition.Dirty = true
+			dirtyPartitions = append(dirtyPartitions, partition)
+		}
+
+		var row *windowRowItem
+		if item := partition.Rows.Get(&windowRowItem{Key: orderByKey, Values: record.Values, DirectionMultipliers: w.orderByDirectionMultipliers}); item != nil {
+			var ok bool
+			row, ok = item.(*windowRowItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid window row item: %v", item))
+			}
+		} else {
+			if record.Retraction {
+				return fmt.Errorf("window received retraction of record which wasn't received before")
+			}
+
+			arguments := make([][]octosql.Value, len(w.functions))
+			for i := range w.functions {
+				arguments[i] = make([]octosql.Value, len(w.functions[i].Arguments))
+				for j, expr := range w.functions[i].Arguments {
+					value, err := expr.Evaluate(ctx)
+					if err != nil {
+						return fmt.Errorf("couldn't evaluate %d argument of %d window function: %w", j, i, err)
+					}
+					arguments[i][j] = value
+				}
+			}
+
+			row = &windowRowItem{
+				Key:                  orderByKey,
+				Values:               record.Values,
+				DirectionMultipliers: w.orderByDirectionMultipliers,
+				EventTime:            record.EventTime,
+				Arguments:            arguments,
+			}
+			partition.Rows.ReplaceOrInsert(row)
+		}
+
+		if !record.Retraction {
+			row.Count++
+			return nil
+		}
+
+		row.Count--
+		if len(row.PreviouslySent) > row.Count {
+			// The remaining copies of the row will get their values recalculated, so we retract the last one.
+			last := row.PreviouslySent[len(row.PreviouslySent)-1]
+			row.PreviouslySent = row.PreviouslySent[:len(row.PreviouslySent)-1]
+			if err := produce(produceCtx, NewRecord(append(append([]octosql.Value{}, row.Values...), last...), true, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce retraction: %w", err)
+			}
+		}
+		if row.Count == 0 {
+			partition.Rows.Delete(row)
+		}
+
+		return nil
+	}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+		if msg.Type == MetadataMessageTypeWatermark {
+			if err := w.recalculatePartitions(produceCtx, partitions, dirtyPartitions, produce); err != nil {
+				return fmt.Errorf("couldn't recalculate partitions on watermark: %w", err)
+			}
+			dirtyPartitions = dirtyPartitions[:0]
+		}
+		return metaSend(produceCtx, msg)
+	}); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	if err := w.recalculatePartitions(ProduceFromExecutionContext(ctx), partitions, dirtyPartitions, produce); err != nil {
+		return fmt.Errorf("couldn't recalculate partitions on end of stream: %w", err)
+	}
+
+	return nil
+}
+
+type windowRow struct {
+	item *windowRowItem
+	// copyIndex is the index of this copy among the copies of a duplicated row.
+	copyIndex int
+}
+
+func (w *Window) recalculatePartitions(produceCtx ProduceContext, partitions *btree.BTree, dirtyPartitions []*windowPartition, produce ProduceFn) error {
+	for _, partition := range dirtyPartitions {
+		partition.Dirty = false
+
+		rows := make([]windowRow, 0, partition.Rows.Len())
+		partition.Rows.Ascend(func(item btree.Item) bool {
+			itemTyped, ok := item.(*windowRowItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid window row item: %v", item))
+			}
+			for i := 0; i < itemTyped.Count; i++ {
+				rows = append(rows, windowRow{item: itemTyped, copyIndex: i})
+			}
+			return true
+		})
+		if len(rows) == 0 {
+			partitions.Delete(partition)
+			continue
+		}
+
+		// Rows with equal order by keys are peers, they share the same frame.
+		peerGroupStart := make([]int, len(rows))
+		peerGroupEnd := make([]int, len(rows))
+		peerGroupIndex := make([]int, len(rows))
+		for i := range rows {
+			if i > 0 && valueSlicesEqual(rows[i-1].item.Key, rows[i].item.Key) {
+				peerGroupStart[i] = peerGroupStart[i-1]
+				peerGroupIndex[i] = peerGroupIndex[i-1]
+			} else {
+				peerGroupStart[i] = i
+				if i > 0 {
+					peerGroupIndex[i] = peerGroupIndex[i-1] + 1
+				}
+			}
+		}
+		for i := len(rows) - 1; i >= 0; i-- {
+			if i < len(rows)-1 && peerGroupStart[i] == peerGroupStart[i+1] {
+				peerGroupEnd[i] = peerGroupEnd[i+1]
+			} else {
+				peerGroupEnd[i] = i
+			}
+		}
+
+		outputs := make([][]octosql.Value, len(rows))
+		for i := range outputs {
+			outputs[i] = make([]octosql.Value, len(w.functions))
+		}
+		for f, function := range w.functions {
+			switch function.Type {
+			case WindowFunctionTypeRowNumber:
+				for i := range rows {
+					outputs[i][f] = octosql.NewInt(i + 1)
+				}
+			case WindowFunctionTypeRank:
+				for i := range rows {
+					outputs[i][f] = octosql.NewInt(peerGroupStart[i] + 1)
+				}
+			case WindowFunctionTypeDenseRank:
+				for i := range rows {
+					outputs[i][f] = octosql.NewInt(peerGroupIndex[i] + 1)
+				}
+			case WindowFunctionTypeLag, WindowFunctionTypeLead:
+				for i := range rows {
+					arguments := rows[i].item.Arguments[f]
+					offset := 1
+					if len(arguments) > 1 {
+						if arguments[1].TypeID == octosql.TypeIDNull {
+							outputs[i][f] = octosql.NewNull()
+							continue
+						}
+						offset = arguments[1].Int
+					}
+					if function.Type == WindowFunctionTypeLag {
+						offset = -offset
+					}
+					if index := i + offset; index >= 0 && index < len(rows) {
+						outputs[i][f] = rows[index].item.Arguments[f][0]
+					} else if len(arguments) > 2 {
+						outputs[i][f] = arguments[2]
+					} else {
+						outputs[i][f] = octosql.NewNull()
+					}
+				}
+			case WindowFunctionTypeFirstValue:
+				for i := range rows {
+					outputs[i][f] = rows[0].item.Arguments[f][0]
+				}
+			case WindowFunctionTypeLastValue:
+				for i := range rows {
+					outputs[i][f] = rows[peerGroupEnd[i]].item.Arguments[f][0]
+				}
+			case WindowFunctionTypeAggregate:
+				aggregate := function.AggregatePrototype()
+				// Like in the group by, the aggregated set size omits NULL inputs.
+				aggregatedSetSize := 0
+				for i := range rows {
+					if value := rows[i].item.Arguments[f][0]; value.TypeID != octosql.TypeIDNull {
+						aggregate.Add(false, value)
+						aggregatedSetSize++
+					}
+					if i != peerGroupEnd[i] {
+						continue
+					}
+					output := octosql.NewNull()
+					if aggregatedSetSize > 0 {
+						output = aggregate.Trigger()
+					}
+					for j := peerGroupStart[i]; j <= i; j++ {
+						outputs[j][f] = output
+					}
+				}
+			default:
+				panic(fmt.Sprintf("invalid window function type: %d", function.Type))
+			}
+		}
+
+		for i, row := range rows {
+			var previous []octosql.Value
+			if row.copyIndex < len(row.item.PreviouslySent) {
+				previous = row.item.PreviouslySent[row.copyIndex]
+				if valueSlicesEqual(previous, outputs[i]) {
+					continue
+				}
+				if err := produce(produceCtx, NewRecord(append(append([]octosql.Value{}, row.item.Values...), previous...), true, row.item.EventTime)); err != nil {
+					return fmt.Errorf("couldn't produce retraction: %w", err)
+				}
+				row.item.PreviouslySent[row.copyIndex] = outputs[i]
+			} else {
+				row.item.PreviouslySent = append(row.item.PreviouslySent, outputs[i])
+			}
+			if err := produce(produceCtx, NewRecord(append(append([]octosql.Value{}, row.item.Values...), outputs[i]...), false, row.item.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func valueSlicesEqual(a, b []octosql.Value) bool {
+	for i := range a {
+		if a[i].Compare(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -7,13 +7,18 @@ import (
 	"github.com/google/btree"
 )
 
+// RecordEventTimeBuffer holds records until the watermark reaches their event time.
 type RecordEventTimeBuffer struct {
-	tree *btree.BTree
+	tree        *btree.BTree
+	lateRecords *LateRecords
+	// The watermark of the last Emit call.
+	watermark time.Time
 }
 
-func NewRecordEventTimeBuffer() *RecordEventTimeBuffer {
+func NewRecordEventTimeBuffer(lateRecords *LateRecords) *RecordEventTimeBuffer {
 	return &RecordEventTimeBuffer{
-		tree: btree.New(BTreeDefaultDegree),
+		tree:        btree.New(BTreeDefaultDegree),
+		lateRecords: lateRecords,
 	}
 }
 
@@ -31,7 +36,16 @@ func (e *recordEventTimeBufferItem) Less(than btree.Item) bool {
 	return e.EventTime.Before(thanTyped.EventTime)
 }
 
-func (b *RecordEventTimeBuffer) AddRecord(record Record) {
+// AddRecord buffers the record. Records which are already behind the watermark get emitted by the next Emit call,
+// unless they're too late, in which case they get dropped.
+func (b *RecordEventTimeBuffer) AddRecord(record Record) error {
+	if b.lateRecords.TooLate(record.EventTime, b.watermark) {
+		if err := b.lateRecords.Drop(record); err != nil {
+			return fmt.Errorf("couldn't drop late record: %w", err)
+		}
+		return nil
+	}
+
 	item := b.tree.Get(&recordEventTimeBufferItem{EventTime: record.EventTime})
 	var itemTyped *recordEventTimeBufferItem
 
@@ -47,9 +61,13 @@ func (b *RecordEventTimeBuffer) AddRecord(record Record) {
 	}
 
 	itemTyped.Records = append(itemTyped.Records, record)
+	return nil
 }
 
 func (b *RecordEventTimeBuffer) Emit(watermark time.Time, produce func(record Record) error) error {
+	if watermark.After(b.watermark) {
+		b.watermark = watermark
+	}
 	min := b.tree.Min()
 	for min != nil && !min.(*recordEventTimeBufferItem).EventTime.After(watermark) {
 		b.tree.DeleteMin()
@@ -0,0 +1,230 @@
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+func writeEntry(w *bufio.Writer, entry *Entry) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], int64(entry.Count))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	if err := writeValues(w, entry.Key); err != nil {
+		return err
+	}
+	return writeValues(w, entry.Values)
+}
+
+func readEntry(r *bufio.Reader) (Entry, error) {
+	count, err := binary.ReadVarint(r)
+	if err != nil {
+		// io.EOF here means there are no more entries.
+		return Entry{}, err
+	}
+	key, err := readValues(r)
+	if err != nil {
+		return Entry{}, unexpectedEOF(err)
+	}
+	values, err := readValues(r)
+	if err != nil {
+		return Entry{}, unexpectedEOF(err)
+	}
+	return Entry{Key: key, Values: values, Count: int(count)}, nil
+}
+
+// EncodeValues encodes the values into bytes, which are only equal for identical values.
+func EncodeValues(values []octosql.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriterSize(&buf, 64)
+	if err := writeValues(w, values); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeValues(w *bufio.Writer, values []octosql.Value) error {
+	if err := writeUvarint(w, uint64(len(values))); err != nil {
+		return err
+	}
+	for i := range values {
+		if err := writeValue(w, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeValue(w *bufio.Writer, value octosql.Value) error {
+	if err := w.WriteByte(byte(value.TypeID)); err != nil {
+		return err
+	}
+	var buf [binary.MaxVarintLen64]byte
+	switch value.TypeID {
+	case octosql.TypeIDNull:
+		return nil
+	case octosql.TypeIDInt:
+		n := binary.PutVarint(buf[:], int64(value.Int))
+		_, err := w.Write(buf[:n])
+		return err
+	case octosql.TypeIDFloat:
+		binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(value.Float))
+		_, err := w.Write(buf[:8])
+		return err
+	case octosql.TypeIDBoolean:
+		if value.Boolean {
+			return w.WriteByte(1)
+		}
+		return w.WriteByte(0)
+	case octosql.TypeIDString:
+		if err := writeUvarint(w, uint64(len(value.Str))); err != nil {
+			return err
+		}
+		_, err := w.WriteString(value.Str)
+		return err
+	case octosql.TypeIDTime:
+		data, err := value.Time.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("couldn't marshal time: %w", err)
+		}
+		if err := writeUvarint(w, uint64(len(data))); err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case octosql.TypeIDDuration:
+		n := binary.PutVarint(buf[:], int64(value.Duration))
+		_, err := w.Write(buf[:n])
+		return err
+	case octosql.TypeIDList:
+		return writeValues(w, value.List)
+	case octosql.TypeIDStruct:
+		return writeValues(w, value.Struct)
+	case octosql.TypeIDTuple:
+		return writeValues(w, value.Tuple)
+	default:
+		return fmt.Errorf("can't spill value of type %s", value.TypeID)
+	}
+}
+
+func writeUvarint(w *bufio.Writer, x uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+func readValues(r *bufio.Reader) ([]octosql.Value, error) {
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]octosql.Value, count)
+	for i := range values {
+		if values[i], err = readValue(r); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func readValue(r *bufio.Reader) (octosql.Value, error) {
+	typeID, err := r.ReadByte()
+	if err != nil {
+		return octosql.Value{}, err
+	}
+	switch octosql.TypeID(typeID) {
+	case octosql.TypeIDNull:
+		return octosql.NewNull(), nil
+	case octosql.TypeIDInt:
+		x, err := binary.ReadVarint(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewInt(int(x)), nil
+	case octosql.TypeIDFloat:
+		var buf [8]byte
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewFloat(math.Float64frombits(binary.LittleEndian.Uint64(buf[:]))), nil
+	case octosql.TypeIDBoolean:
+		b, err := r.ReadByte()
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewBoolean(b == 1), nil
+	case octosql.TypeIDString:
+		data, err := readBytes(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewString(string(data)), nil
+	case octosql.TypeIDTime:
+		data, err := readBytes(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary(data); err != nil {
+			return octosql.Value{}, fmt.Errorf("couldn't unmarshal time: %w", err)
+		}
+		return octosql.NewTime(t), nil
+	case octosql.TypeIDDuration:
+		x, err := binary.ReadVarint(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewDuration(time.Duration(x)), nil
+	case octosql.TypeIDList:
+		values, err := readValues(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewList(values), nil
+	case octosql.TypeIDStruct:
+		values, err := readValues(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewStruct(values), nil
+	case octosql.TypeIDTuple:
+		values, err := readValues(r)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		return octosql.NewTuple(values), nil
+	default:
+		return octosql.Value{}, fmt.Errorf("invalid spilled value type: %d", typeID)
+	}
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,320 @@
+// Package spill lets stateful operators keep state larger than their memory budget,
+// by writing it to sorted run files on local disk and merging them back when producing results.
+package spill
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"unsafe"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// Entry is a single unit of spilled state.
+// Operators decide what the key and values are, the count is usually the number of records minus retractions.
+type Entry struct {
+	Key    []octosql.Value
+	Values []octosql.Value
+	Count  int
+}
+
+var valueSize = int(unsafe.Sizeof(octosql.Value{}))
+
+// Size estimates the memory used by the values.
+func Size(values []octosql.Value) int {
+	size := 0
+	for i := range values {
+		size += valueSize + len(values[i].Str)
+		size += Size(values[i].List) + Size(values[i].Struct) + Size(values[i].Tuple)
+	}
+	return size
+}
+
+// Sorter buffers entries in memory and writes them as sorted runs to disk once they exceed the memory budget.
+// Merge then returns all entries in order, merging the runs.
+// Entries which are equal according to less are returned next to each other, in the order they were added.
+type Sorter struct {
+	budget int
+	less   func(a, b *Entry) bool
+
+	buffered     []Entry
+	bufferedSize int
+
+	dir       string
+	runs      []string
+	runsTotal int
+}
+
+func NewSorter(budget int, less func(a, b *Entry) bool) *Sorter {
+	return &Sorter{
+		budget: budget,
+		less:   less,
+	}
+}
+
+// Spilled returns whether any entries have been written to disk.
+func (s *Sorter) Spilled() bool {
+	return len(s.runs) > 0
+}
+
+func (s *Sorter) Add(entry Entry) error {
+	s.buffered = append(s.buffered, entry)
+	s.bufferedSize += Size(entry.Key) + Size(entry.Values)
+	if s.bufferedSize > s.budget {
+		if err := s.flush(); err != nil {
+			return fmt.Errorf("couldn't spill buffered entries: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Sorter) flush() error {
+	sort.SliceStable(s.buffered, func(i, j int) bool {
+		return s.less(&s.buffered[i], &s.buffered[j])
+	})
+	if err := s.AddRun(func(add func(entry *Entry) error) error {
+		for i := range s.buffered {
+			if err := add(&s.buffered[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.buffered = nil
+	s.bufferedSize = 0
+	return nil
+}
+
+// AddRun writes the entries passed to add as a run. They must already be sorted.
+func (s *Sorter) AddRun(entries func(add func(entry *Entry) error) error) error {
+	if s.dir == "" {
+		dir, err := os.MkdirTemp("", "octosql-spill-")
+		if err != nil {
+			return fmt.Errorf("couldn't create spill directory: %w", err)
+		}
+		s.dir = dir
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("run-%d", s.runsTotal))
+	s.runsTotal++
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("couldn't create run file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := entries(func(entry *Entry) error {
+		return writeEntry(w, entry)
+	}); err != nil {
+		return fmt.Errorf("couldn't write run file: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("couldn't write run file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("couldn't close run file: %w", err)
+	}
+	s.runs = append(s.runs, path)
+
+	if len(s.runs) >= maxRunCount {
+		if err := s.compactRuns(); err != nil {
+			return fmt.Errorf("couldn't compact runs: %w", err)
+		}
+	}
+	return nil
+}
+
+// maxRunCount limits the number of run files open at the same time while merging.
+const maxRunCount = 64
+
+// compactRuns merges all the runs into a single one.
+func (s *Sorter) compactRuns() error {
+	buffered := s.buffered
+	s.buffered = nil
+	defer func() {
+		s.buffered = buffered
+	}()
+
+	it, err := s.Merge()
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	oldRuns := s.runs
+	s.runs = nil
+	if err := s.AddRun(func(add func(entry *Entry) error) error {
+		for {
+			entry, ok, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			if err := add(&entry); err != nil {
+				return err
+			}
+		}
+	}); err != nil {
+		return err
+	}
+	for _, path := range oldRuns {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("couldn't remove merged run file: %w", err)
+		}
+	}
+	return nil
+}
+
+// Merge returns an iterator over all the added entries, in order.
+func (s *Sorter) Merge() (*Iterator, error) {
+	sort.SliceStable(s.buffered, func(i, j int) bool {
+		return s.less(&s.buffered[i], &s.buffered[j])
+	})
+
+	it := &Iterator{
+		sources: sources{less: s.less},
+	}
+	for i, path := range s.runs {
+		f, err := os.Open(path)
+		if err != nil {
+			it.Close()
+			return nil, fmt.Errorf("couldn't open run file: %w", err)
+		}
+		it.sources.items = append(it.sources.items, &source{index: i, file: f, reader: bufio.NewReader(f)})
+	}
+	if len(s.buffered) > 0 {
+		it.sources.items = append(it.sources.items, &source{index: len(s.runs), buffered: s.buffered})
+	}
+	for i := 0; i < len(it.sources.items); i++ {
+		ok, err := it.sources.items[i].next()
+		if err != nil {
+			it.Close()
+			return nil, fmt.Errorf("couldn't read run file: %w", err)
+		}
+		if !ok {
+			if it.sources.items[i].file != nil {
+				it.sources.items[i].file.Close()
+			}
+			it.sources.items = append(it.sources.items[:i], it.sources.items[i+1:]...)
+			i--
+		}
+	}
+	heap.Init(&it.sources)
+	return it, nil
+}
+
+// Close removes the run files.
+func (s *Sorter) Close() error {
+	if s.dir == "" {
+		return nil
+	}
+	if err := os.RemoveAll(s.dir); err != nil {
+		return fmt.Errorf("couldn't remove spill directory: %w", err)
+	}
+	return nil
+}
+
+type source struct {
+	// Sources with a lower index contain entries added earlier.
+	index   int
+	current Entry
+
+	buffered []Entry
+
+	file   *os.File
+	reader *bufio.Reader
+}
+
+func (s *source) next() (bool, error) {
+	if s.reader == nil {
+		if len(s.buffered) == 0 {
+			return false, nil
+		}
+		s.current = s.buffered[0]
+		s.buffered = s.buffered[1:]
+		return true, nil
+	}
+	entry, err := readEntry(s.reader)
+	if err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	s.current = entry
+	return true, nil
+}
+
+type sources struct {
+	items []*source
+	less  func(a, b *Entry) bool
+}
+
+func (s *sources) Len() int { return len(s.items) }
+func (s *sources) Less(i, j int) bool {
+	if s.less(&s.items[i].current, &s.items[j].current) {
+		return true
+	} else if s.less(&s.items[j].current, &s.items[i].current) {
+		return false
+	}
+	return s.items[i].index < s.items[j].index
+}
+func (s *sources) Swap(i, j int) { s.items[i], s.items[j] = s.items[j], s.items[i] }
+func (s *sources) Push(x any)    { s.items = append(s.items, x.(*source)) }
+func (s *sources) Pop() any {
+	last := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return last
+}
+
+// Iterator merges the sorted runs.
+type Iterator struct {
+	sources sources
+}
+
+// Peek returns the next entry without advancing the iterator.
+// The returned entry is only valid until Next is called.
+func (it *Iterator) Peek() (*Entry, bool) {
+	if len(it.sources.items) == 0 {
+		return nil, false
+	}
+	return &it.sources.items[0].current, true
+}
+
+// Next returns the next entry.
+func (it *Iterator) Next() (Entry, bool, error) {
+	if len(it.sources.items) == 0 {
+		return Entry{}, false, nil
+	}
+	top := it.sources.items[0]
+	entry := top.current
+	ok, err := top.next()
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("couldn't read run file: %w", err)
+	}
+	if ok {
+		heap.Fix(&it.sources, 0)
+	} else {
+		heap.Pop(&it.sources)
+		if top.file != nil {
+			top.file.Close()
+		}
+	}
+	return entry, true, nil
+}
+
+func (it *Iterator) Close() {
+	for _, source := range it.sources.items {
+		if source.file != nil {
+			source.file.Close()
+		}
+	}
+}
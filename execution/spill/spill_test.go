@@ -0,0 +1,131 @@
+package spill
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+func TestSorter(t *testing.T) {
+	sorter := NewSorter(0, func(a, b *Entry) bool {
+		return a.Key[0].Int < b.Key[0].Int
+	})
+	defer sorter.Close()
+
+	// With a zero budget, every entry gets its own run, so the runs get compacted too.
+	for i := 0; i < 200; i++ {
+		require.NoError(t, sorter.Add(Entry{
+			Key:    []octosql.Value{octosql.NewInt((i * 7) % 10)},
+			Values: []octosql.Value{octosql.NewInt(i)},
+			Count:  1,
+		}))
+	}
+	assert.True(t, sorter.Spilled())
+
+	it, err := sorter.Merge()
+	require.NoError(t, err)
+	defer it.Close()
+
+	var previous *Entry
+	count := 0
+	for {
+		entry, ok, err := it.Next()
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		count++
+		if previous != nil {
+			assert.LessOrEqual(t, previous.Key[0].Int, entry.Key[0].Int)
+			if previous.Key[0].Int == entry.Key[0].Int {
+				// Equal entries keep the order they were added in.
+				assert.Less(t, previous.Values[0].Int, entry.Values[0].Int)
+			}
+		}
+		previous = &entry
+	}
+	assert.Equal(t, 200, count)
+}
+
+func TestEncoding(t *testing.T) {
+	values := []octosql.Value{
+		octosql.NewNull(),
+		octosql.NewInt(-42),
+		octosql.NewFloat(3.5),
+		octosql.NewBoolean(true),
+		octosql.NewString("hello"),
+		octosql.NewTime(time.Date(2022, 1, 1, 10, 0, 0, 5, time.FixedZone("CET", 3600))),
+		octosql.NewDuration(time.Minute),
+		octosql.NewList([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}),
+		octosql.NewStruct([]octosql.Value{octosql.NewBoolean(false)}),
+		octosql.NewTuple([]octosql.Value{octosql.NewFloat(-1), octosql.NewNull()}),
+	}
+
+	sorter := NewSorter(0, func(a, b *Entry) bool { return false })
+	defer sorter.Close()
+	require.NoError(t, sorter.Add(Entry{Key: values[:1], Values: values, Count: -3}))
+
+	it, err := sorter.Merge()
+	require.NoError(t, err)
+	defer it.Close()
+
+	entry, ok, err := it.Next()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, -3, entry.Count)
+	require.Len(t, entry.Values, len(values))
+	for i := range values {
+		assert.Equal(t, 0, values[i].Compare(entry.Values[i]), "value %d: %s != %s", i, values[i], entry.Values[i])
+	}
+	// The zone name isn't kept, but the offset is.
+	assert.Equal(t, values[5].Time.Format(time.RFC3339Nano), entry.Values[5].Time.Format(time.RFC3339Nano))
+
+	_, ok, err = it.Next()
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestTable(t *testing.T) {
+	for _, budget := range []int{0, 1024, 1 << 30} {
+		table := NewTable(budget)
+
+		// Reference state of each key.
+		expected := make(map[int][]int)
+		for i := 0; i < 1000; i++ {
+			key := (i * 7) % 23
+			if i%50 == 49 {
+				require.NoError(t, table.Delete([]octosql.Value{octosql.NewInt(key)}))
+				delete(expected, key)
+				continue
+			}
+			require.NoError(t, table.Add(Entry{
+				Key:    []octosql.Value{octosql.NewInt(key)},
+				Values: []octosql.Value{octosql.NewInt(i)},
+				Count:  1,
+			}))
+			expected[key] = append(expected[key], i)
+		}
+		assert.Equal(t, budget < 1<<30, table.Spilled())
+
+		for key := 0; key < 30; key++ {
+			entries, err := table.Get([]octosql.Value{octosql.NewInt(key)})
+			require.NoError(t, err)
+			values := make([]int, len(entries))
+			for i := range entries {
+				assert.Equal(t, key, entries[i].Key[0].Int)
+				values[i] = entries[i].Values[0].Int
+			}
+			if len(expected[key]) == 0 {
+				assert.Empty(t, values, "key %d", key)
+			} else {
+				// Entries are returned in the order they were added.
+				assert.Equal(t, expected[key], values, "key %d", key)
+			}
+		}
+		require.NoError(t, table.Close())
+	}
+}
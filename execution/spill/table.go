@@ -0,0 +1,517 @@
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// Table keeps entries grouped by their key, for operators which need to look up the state of single keys.
+// It buffers entries in memory and writes them as runs sorted by key to disk once they exceed the memory budget.
+// Each run has a sparse index and a bloom filter in memory, so that a lookup only reads the part of a run which may contain the key.
+// Keys are compared by their encoding, see EncodeValues.
+type Table struct {
+	budget int
+
+	buffered     map[string]*tableItem
+	bufferedSize int
+
+	dir       string
+	runs      []*tableRun
+	runsTotal int
+}
+
+type tableItem struct {
+	// Deleted is set if the entries of the key in the runs have been deleted.
+	deleted bool
+	entries []Entry
+}
+
+func NewTable(budget int) *Table {
+	return &Table{
+		budget:   budget,
+		buffered: make(map[string]*tableItem),
+	}
+}
+
+// Spilled returns whether any entries have been written to disk.
+func (t *Table) Spilled() bool {
+	return len(t.runs) > 0
+}
+
+// Add appends the entry to the entries of its key.
+func (t *Table) Add(entry Entry) error {
+	key, err := EncodeValues(entry.Key)
+	if err != nil {
+		return fmt.Errorf("couldn't encode key: %w", err)
+	}
+	item, ok := t.buffered[string(key)]
+	if !ok {
+		item = &tableItem{}
+		t.buffered[string(key)] = item
+	}
+	item.entries = append(item.entries, entry)
+	t.bufferedSize += Size(entry.Key) + Size(entry.Values)
+	if t.bufferedSize > t.budget {
+		if err := t.flush(); err != nil {
+			return fmt.Errorf("couldn't spill buffered entries: %w", err)
+		}
+	}
+	return nil
+}
+
+// Delete removes all the entries of the key.
+func (t *Table) Delete(key []octosql.Value) error {
+	encodedKey, err := EncodeValues(key)
+	if err != nil {
+		return fmt.Errorf("couldn't encode key: %w", err)
+	}
+	if item, ok := t.buffered[string(encodedKey)]; ok {
+		for i := range item.entries {
+			t.bufferedSize -= Size(item.entries[i].Key) + Size(item.entries[i].Values)
+		}
+	}
+	for _, run := range t.runs {
+		if run.filter.mayContain(encodedKey) {
+			t.buffered[string(encodedKey)] = &tableItem{deleted: true}
+			return nil
+		}
+	}
+	delete(t.buffered, string(encodedKey))
+	return nil
+}
+
+// Get returns the entries of the key, in the order they were added.
+func (t *Table) Get(key []octosql.Value) ([]Entry, error) {
+	encodedKey, err := EncodeValues(key)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encode key: %w", err)
+	}
+	var entries []Entry
+	for _, run := range t.runs {
+		if !run.filter.mayContain(encodedKey) {
+			continue
+		}
+		if entries, err = run.get(encodedKey, key, entries); err != nil {
+			return nil, fmt.Errorf("couldn't read run file: %w", err)
+		}
+	}
+	if item, ok := t.buffered[string(encodedKey)]; ok {
+		if item.deleted {
+			entries = nil
+		}
+		entries = append(entries, item.entries...)
+	}
+	return entries, nil
+}
+
+func (t *Table) flush() error {
+	keys := make([]string, 0, len(t.buffered))
+	for key := range t.buffered {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := t.addRun(len(keys), func(w *tableRunWriter) error {
+		for _, key := range keys {
+			item := t.buffered[key]
+			if item.deleted {
+				if err := w.writeTombstone([]byte(key)); err != nil {
+					return err
+				}
+			}
+			for i := range item.entries {
+				if err := w.writeEntry([]byte(key), &item.entries[i]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	t.buffered = make(map[string]*tableItem)
+	t.bufferedSize = 0
+	return nil
+}
+
+func (t *Table) addRun(keyCount int, records func(w *tableRunWriter) error) error {
+	if t.dir == "" {
+		dir, err := os.MkdirTemp("", "octosql-spill-")
+		if err != nil {
+			return fmt.Errorf("couldn't create spill directory: %w", err)
+		}
+		t.dir = dir
+	}
+	path := filepath.Join(t.dir, fmt.Sprintf("table-%d", t.runsTotal))
+	t.runsTotal++
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("couldn't create run file: %w", err)
+	}
+
+	w := &tableRunWriter{
+		counter: countingWriter{w: f},
+		run:     &tableRun{path: path, filter: newBloomFilter(keyCount)},
+	}
+	w.writer = bufio.NewWriter(&w.counter)
+	if err := records(w); err != nil {
+		f.Close()
+		return fmt.Errorf("couldn't write run file: %w", err)
+	}
+	if err := w.writer.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("couldn't write run file: %w", err)
+	}
+	w.run.file = f
+	w.run.size = w.counter.n
+	t.runs = append(t.runs, w.run)
+
+	if len(t.runs) >= maxRunCount {
+		if err := t.compactRuns(); err != nil {
+			return fmt.Errorf("couldn't compact runs: %w", err)
+		}
+	}
+	return nil
+}
+
+// compactRuns merges all the runs into a single one, dropping the deleted entries.
+func (t *Table) compactRuns() error {
+	readers := make([]*tableRunReader, len(t.runs))
+	keyCount := 0
+	for i, run := range t.runs {
+		readers[i] = run.reader(0)
+		if err := readers[i].next(); err != nil {
+			return fmt.Errorf("couldn't read run file: %w", err)
+		}
+		keyCount += run.filter.keyCount
+	}
+
+	oldRuns := t.runs
+	t.runs = nil
+	if err := t.addRun(keyCount, func(w *tableRunWriter) error {
+		for {
+			// The runs are sorted by key, so the smallest current key is the next one.
+			var key []byte
+			for _, r := range readers {
+				if !r.done && (key == nil || bytes.Compare(r.key, key) < 0) {
+					key = r.key
+				}
+			}
+			if key == nil {
+				return nil
+			}
+			key = append([]byte(nil), key...)
+
+			// Older runs come first, so only the entries after the last tombstone are kept.
+			var entries []Entry
+			for _, r := range readers {
+				for !r.done && bytes.Equal(r.key, key) {
+					if r.tombstone {
+						entries = entries[:0]
+					} else {
+						entry, err := r.entry()
+						if err != nil {
+							return fmt.Errorf("couldn't read run file: %w", err)
+						}
+						entries = append(entries, entry)
+					}
+					if err := r.next(); err != nil {
+						return fmt.Errorf("couldn't read run file: %w", err)
+					}
+				}
+			}
+			for i := range entries {
+				if err := w.writeEntry(key, &entries[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}); err != nil {
+		return err
+	}
+	for _, run := range oldRuns {
+		if err := run.remove(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close removes the run files.
+func (t *Table) Close() error {
+	for _, run := range t.runs {
+		run.file.Close()
+	}
+	t.runs = nil
+	if t.dir == "" {
+		return nil
+	}
+	if err := os.RemoveAll(t.dir); err != nil {
+		return fmt.Errorf("couldn't remove spill directory: %w", err)
+	}
+	t.dir = ""
+	return nil
+}
+
+// tableBlockSize is the approximate number of bytes of a run between the keys in its index.
+const tableBlockSize = 4096
+
+type tableRun struct {
+	path string
+	file *os.File
+	size int64
+	// index contains the first key of each block of the run, and the offset of the block.
+	index  []tableIndexItem
+	filter *bloomFilter
+}
+
+type tableIndexItem struct {
+	key    []byte
+	offset int64
+}
+
+func (r *tableRun) get(encodedKey []byte, key []octosql.Value, entries []Entry) ([]Entry, error) {
+	if len(r.index) == 0 {
+		return entries, nil
+	}
+	// Entries of the key may start in the block before the first one beginning with a key not less than it.
+	block := sort.Search(len(r.index), func(i int) bool {
+		return bytes.Compare(r.index[i].key, encodedKey) >= 0
+	})
+	if block > 0 {
+		block--
+	}
+
+	reader := r.reader(r.index[block].offset)
+	for {
+		if err := reader.next(); err != nil {
+			return nil, err
+		}
+		if reader.done {
+			return entries, nil
+		}
+		switch comparison := bytes.Compare(reader.key, encodedKey); {
+		case comparison < 0:
+			continue
+		case comparison > 0:
+			return entries, nil
+		}
+		if reader.tombstone {
+			entries = nil
+			continue
+		}
+		entry, err := reader.entry()
+		if err != nil {
+			return nil, err
+		}
+		entry.Key = key
+		entries = append(entries, entry)
+	}
+}
+
+func (r *tableRun) reader(offset int64) *tableRunReader {
+	return &tableRunReader{reader: bufio.NewReaderSize(io.NewSectionReader(r.file, offset, r.size-offset), tableBlockSize)}
+}
+
+func (r *tableRun) remove() error {
+	r.file.Close()
+	if err := os.Remove(r.path); err != nil {
+		return fmt.Errorf("couldn't remove merged run file: %w", err)
+	}
+	return nil
+}
+
+const (
+	tableRecordEntry byte = iota
+	tableRecordTombstone
+)
+
+type tableRunWriter struct {
+	counter countingWriter
+	writer  *bufio.Writer
+	run     *tableRun
+
+	// The payload of an entry is written here first, so that it can be prefixed with its length.
+	payload       bytes.Buffer
+	payloadWriter *bufio.Writer
+
+	lastKey         []byte
+	lastIndexOffset int64
+}
+
+func (w *tableRunWriter) writeEntry(key []byte, entry *Entry) error {
+	if err := w.writeKey(key, tableRecordEntry); err != nil {
+		return err
+	}
+
+	w.payload.Reset()
+	if w.payloadWriter == nil {
+		w.payloadWriter = bufio.NewWriter(&w.payload)
+	}
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], int64(entry.Count))
+	if _, err := w.payloadWriter.Write(buf[:n]); err != nil {
+		return err
+	}
+	if err := writeValues(w.payloadWriter, entry.Values); err != nil {
+		return err
+	}
+	if err := w.payloadWriter.Flush(); err != nil {
+		return err
+	}
+
+	if err := writeUvarint(w.writer, uint64(w.payload.Len())); err != nil {
+		return err
+	}
+	_, err := w.writer.Write(w.payload.Bytes())
+	return err
+}
+
+func (w *tableRunWriter) writeTombstone(key []byte) error {
+	return w.writeKey(key, tableRecordTombstone)
+}
+
+func (w *tableRunWriter) writeKey(key []byte, recordType byte) error {
+	offset := w.counter.n + int64(w.writer.Buffered())
+	if len(w.run.index) == 0 || offset-w.lastIndexOffset >= tableBlockSize {
+		w.run.index = append(w.run.index, tableIndexItem{key: append([]byte(nil), key...), offset: offset})
+		w.lastIndexOffset = offset
+	}
+	if !bytes.Equal(key, w.lastKey) {
+		w.run.filter.add(key)
+		w.lastKey = append(w.lastKey[:0], key...)
+	}
+
+	if err := writeUvarint(w.writer, uint64(len(key))); err != nil {
+		return err
+	}
+	if _, err := w.writer.Write(key); err != nil {
+		return err
+	}
+	return w.writer.WriteByte(recordType)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// tableRunReader reads the records of a run.
+// The payload of an entry is only decoded if requested, otherwise it's skipped.
+type tableRunReader struct {
+	reader *bufio.Reader
+
+	done      bool
+	key       []byte
+	tombstone bool
+	// The length of the payload of the current entry, if it hasn't been read yet.
+	payloadLength int
+}
+
+func (r *tableRunReader) next() error {
+	if r.payloadLength > 0 {
+		if _, err := r.reader.Discard(r.payloadLength); err != nil {
+			return unexpectedEOF(err)
+		}
+		r.payloadLength = 0
+	}
+
+	keyLength, err := binary.ReadUvarint(r.reader)
+	if err == io.EOF {
+		r.done = true
+		return nil
+	} else if err != nil {
+		return unexpectedEOF(err)
+	}
+	if cap(r.key) < int(keyLength) {
+		r.key = make([]byte, keyLength)
+	}
+	r.key = r.key[:keyLength]
+	if _, err := io.ReadFull(r.reader, r.key); err != nil {
+		return unexpectedEOF(err)
+	}
+	recordType, err := r.reader.ReadByte()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	r.tombstone = recordType == tableRecordTombstone
+	if r.tombstone {
+		return nil
+	}
+	payloadLength, err := binary.ReadUvarint(r.reader)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	r.payloadLength = int(payloadLength)
+	return nil
+}
+
+// entry decodes the payload of the current entry.
+func (r *tableRunReader) entry() (Entry, error) {
+	r.payloadLength = 0
+	count, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return Entry{}, unexpectedEOF(err)
+	}
+	values, err := readValues(r.reader)
+	if err != nil {
+		return Entry{}, unexpectedEOF(err)
+	}
+	return Entry{Values: values, Count: int(count)}, nil
+}
+
+// bloomFilter tells if a run may contain a key, using around 10 bits per key.
+type bloomFilter struct {
+	bits     []uint64
+	keyCount int
+}
+
+const bloomFilterHashCount = 6
+
+func newBloomFilter(keyCount int) *bloomFilter {
+	return &bloomFilter{bits: make([]uint64, keyCount*10/64+1)}
+}
+
+func (f *bloomFilter) add(key []byte) {
+	f.keyCount++
+	h1, h2 := bloomFilterHashes(key)
+	size := uint32(len(f.bits) * 64)
+	for i := uint32(0); i < bloomFilterHashCount; i++ {
+		bit := (h1 + i*h2) % size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (f *bloomFilter) mayContain(key []byte) bool {
+	h1, h2 := bloomFilterHashes(key)
+	size := uint32(len(f.bits) * 64)
+	for i := uint32(0); i < bloomFilterHashCount; i++ {
+		bit := (h1 + i*h2) % size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func bloomFilterHashes(key []byte) (uint32, uint32) {
+	h := fnv.New64a()
+	h.Write(key)
+	sum := h.Sum64()
+	return uint32(sum), uint32(sum>>32) | 1
+}
@@ -12,7 +12,16 @@ type Trigger interface {
 	WatermarkReceived(watermark time.Time)
 	KeyReceived(key GroupKey)
 	Poll() []GroupKey
-	// ExpireKeysBeforeTime(time time.Time)
+	// ExpireKey drops all state of the key, which won't be received anymore.
+	ExpireKey(key GroupKey)
+}
+
+// ProcessingTimeTrigger is implemented by triggers which fire keys based on the wall-clock time,
+// so they have to be polled even when no records or watermarks arrive.
+type ProcessingTimeTrigger interface {
+	Trigger
+	// NextDeadline returns the earliest wall-clock time at which Poll may return new keys, if there's any.
+	NextDeadline() (time.Time, bool)
 }
 
 type CountingTrigger struct {
@@ -66,6 +75,10 @@ func (c *CountingTrigger) KeyReceived(key GroupKey) {
 	}
 }
 
+func (c *CountingTrigger) ExpireKey(key GroupKey) {
+	c.counts.Delete(key)
+}
+
 // The returned slice will be made invalid after following operations on the trigger.
 func (c *CountingTrigger) Poll() []GroupKey {
 	output := c.toTrigger
@@ -141,6 +154,13 @@ func (c *WatermarkTrigger) KeyReceived(key GroupKey) {
 	})
 }
 
+func (c *WatermarkTrigger) ExpireKey(key GroupKey) {
+	c.timeKeys.Delete(watermarkTriggerKey{
+		Time:     key[c.timeFieldKeyIndex].Time,
+		GroupKey: key,
+	})
+}
+
 // The returned slice will be made invalid after following operations on the trigger.
 func (c *WatermarkTrigger) Poll() []GroupKey {
 	c.outputKeysSlice = c.outputKeysSlice[:0]
@@ -180,6 +200,129 @@ func (c *WatermarkTrigger) Poll() []GroupKey {
 	return c.outputKeysSlice
 }
 
+type delayTriggerKey struct {
+	Deadline time.Time
+	GroupKey GroupKey
+}
+
+func (key delayTriggerKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(delayTriggerKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	if key.Deadline == thanTyped.Deadline {
+		return key.GroupKey.Less(thanTyped.GroupKey)
+	} else {
+		return key.Deadline.Before(thanTyped.Deadline)
+	}
+}
+
+type delayTriggerPendingItem struct {
+	GroupKey
+	Deadline time.Time
+}
+
+// DelayTrigger fires keys a fixed wall-clock duration after they first change since they were last fired.
+type DelayTrigger struct {
+	delay time.Duration
+	now   func() time.Time
+
+	// Pending keys, indexed by deadline and by key.
+	deadlines          *btree.BTree
+	pending            *btree.BTree
+	endOfStreamReached bool
+
+	outputKeysSlice []GroupKey
+}
+
+func NewDelayTriggerPrototype(delay time.Duration) func() Trigger {
+	return func() Trigger {
+		return &DelayTrigger{
+			delay:              delay,
+			now:                time.Now,
+			deadlines:          btree.New(BTreeDefaultDegree),
+			pending:            btree.New(BTreeDefaultDegree),
+			endOfStreamReached: false,
+			outputKeysSlice:    make([]GroupKey, 0),
+		}
+	}
+}
+
+func (c *DelayTrigger) EndOfStreamReached() {
+	c.endOfStreamReached = true
+}
+
+func (c *DelayTrigger) WatermarkReceived(watermark time.Time) {}
+
+func (c *DelayTrigger) KeyReceived(key GroupKey) {
+	if c.pending.Has(key) {
+		return
+	}
+	deadline := c.now().Add(c.delay)
+	c.pending.ReplaceOrInsert(&delayTriggerPendingItem{GroupKey: key, Deadline: deadline})
+	c.deadlines.ReplaceOrInsert(delayTriggerKey{Deadline: deadline, GroupKey: key})
+}
+
+func (c *DelayTrigger) ExpireKey(key GroupKey) {
+	item := c.pending.Delete(key)
+	if item == nil {
+		return
+	}
+	itemTyped, ok := item.(*delayTriggerPendingItem)
+	if !ok {
+		panic(fmt.Sprintf("invalid received item: %v", item))
+	}
+	c.deadlines.Delete(delayTriggerKey{
+		Deadline: itemTyped.Deadline,
+		GroupKey: itemTyped.GroupKey,
+	})
+}
+
+// The returned slice will be made invalid after following operations on the trigger.
+func (c *DelayTrigger) Poll() []GroupKey {
+	c.outputKeysSlice = c.outputKeysSlice[:0]
+	now := c.now()
+	c.deadlines.Ascend(func(item btree.Item) bool {
+		itemTyped, ok := item.(delayTriggerKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+
+		if !c.endOfStreamReached && itemTyped.Deadline.After(now) {
+			return false
+		}
+
+		c.outputKeysSlice = append(c.outputKeysSlice, itemTyped.GroupKey)
+
+		return true
+	})
+	for i := range c.outputKeysSlice {
+		item := c.pending.Delete(c.outputKeysSlice[i])
+		itemTyped, ok := item.(*delayTriggerPendingItem)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+		c.deadlines.Delete(delayTriggerKey{
+			Deadline: itemTyped.Deadline,
+			GroupKey: itemTyped.GroupKey,
+		})
+	}
+	return c.outputKeysSlice
+}
+
+func (c *DelayTrigger) NextDeadline() (time.Time, bool) {
+	item := c.deadlines.Min()
+	if item == nil {
+		return time.Time{}, false
+	}
+	itemTyped, ok := item.(delayTriggerKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid received item: %v", item))
+	}
+	return itemTyped.Deadline, true
+}
+
 type EndOfStreamTrigger struct {
 	keys               *btree.BTree
 	endOfStreamReached bool
@@ -204,6 +347,10 @@ func (c *EndOfStreamTrigger) KeyReceived(key GroupKey) {
 	c.keys.ReplaceOrInsert(key)
 }
 
+func (c *EndOfStreamTrigger) ExpireKey(key GroupKey) {
+	c.keys.Delete(key)
+}
+
 func (c *EndOfStreamTrigger) Poll() []GroupKey {
 	if !c.endOfStreamReached {
 		return nil
@@ -229,8 +376,19 @@ type MultiTrigger struct {
 func NewMultiTriggerPrototype(triggerPrototypes []func() Trigger) func() Trigger {
 	return func() Trigger {
 		triggers := make([]Trigger, len(triggerPrototypes))
+		usesProcessingTime := false
 		for i := range triggerPrototypes {
 			triggers[i] = triggerPrototypes[i]()
+			if _, ok := triggers[i].(ProcessingTimeTrigger); ok {
+				usesProcessingTime = true
+			}
+		}
+		if usesProcessingTime {
+			return &processingTimeMultiTrigger{
+				MultiTrigger: MultiTrigger{
+					triggers: triggers,
+				},
+			}
 		}
 		return &MultiTrigger{
 			triggers: triggers,
@@ -256,6 +414,12 @@ func (c *MultiTrigger) KeyReceived(key GroupKey) {
 	}
 }
 
+func (c *MultiTrigger) ExpireKey(key GroupKey) {
+	for i := range c.triggers {
+		c.triggers[i].ExpireKey(key)
+	}
+}
+
 func (c *MultiTrigger) Poll() []GroupKey {
 	var output []GroupKey
 	for i := range c.triggers {
@@ -263,3 +427,24 @@ func (c *MultiTrigger) Poll() []GroupKey {
 	}
 	return output
 }
+
+// processingTimeMultiTrigger is a MultiTrigger containing at least one ProcessingTimeTrigger.
+type processingTimeMultiTrigger struct {
+	MultiTrigger
+}
+
+func (c *processingTimeMultiTrigger) NextDeadline() (time.Time, bool) {
+	var out time.Time
+	found := false
+	for i := range c.triggers {
+		processingTimeTrigger, ok := c.triggers[i].(ProcessingTimeTrigger)
+		if !ok {
+			continue
+		}
+		if deadline, ok := processingTimeTrigger.NextDeadline(); ok && (!found || deadline.Before(out)) {
+			out = deadline
+			found = true
+		}
+	}
+	return out, found
+}
@@ -2,6 +2,7 @@ package execution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -17,3 +18,44 @@ func TestCountingTrigger(t *testing.T) {
 	assert.Len(t, polled, 1)
 	assert.Equal(t, polled[0], GroupKey{octosql.NewInt(2), octosql.NewInt(3)})
 }
+
+func TestDelayTrigger(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	trigger := NewDelayTriggerPrototype(time.Second * 10)().(*DelayTrigger)
+	trigger.now = func() time.Time { return now }
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	now = now.Add(time.Second * 5)
+	trigger.KeyReceived(GroupKey{octosql.NewInt(2)})
+	// Receiving a pending key again doesn't postpone its deadline.
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Empty(t, trigger.Poll())
+
+	deadline, ok := trigger.NextDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2022, 1, 1, 12, 0, 10, 0, time.UTC), deadline)
+
+	now = now.Add(time.Second * 5)
+	assert.Equal(t, []GroupKey{{octosql.NewInt(1)}}, trigger.Poll())
+
+	now = now.Add(time.Second * 5)
+	assert.Equal(t, []GroupKey{{octosql.NewInt(2)}}, trigger.Poll())
+	_, ok = trigger.NextDeadline()
+	assert.False(t, ok)
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Empty(t, trigger.Poll())
+	trigger.EndOfStreamReached()
+	assert.Equal(t, []GroupKey{{octosql.NewInt(1)}}, trigger.Poll())
+}
+
+func TestExpireKey(t *testing.T) {
+	trigger := NewCountingTriggerPrototype(2)()
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	trigger.ExpireKey(GroupKey{octosql.NewInt(1)})
+	// The count of an expired key starts from scratch.
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Empty(t, trigger.Poll())
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Equal(t, []GroupKey{{octosql.NewInt(1)}}, trigger.Poll())
+}
@@ -120,6 +120,46 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"between": {
+			Description: "Returns true if the first argument is between the second and third argument, inclusive.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 3 {
+							return octosql.Type{}, false
+						}
+						if !types[0].Equals(types[1]) || !types[0].Equals(types[2]) {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) >= 0 && values[0].Compare(values[2]) <= 0), nil
+					},
+				},
+			},
+		},
+		"not between": {
+			Description: "Returns true if the first argument is not between the second and third argument, inclusive.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 3 {
+							return octosql.Type{}, false
+						}
+						if !types[0].Equals(types[1]) || !types[0].Equals(types[2]) {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) < 0 || values[0].Compare(values[2]) > 0), nil
+					},
+				},
+			},
+		},
 		"is null": {
 			Description: "Returns true only if the argument is null.",
 			Descriptors: []physical.FunctionDescriptor{
@@ -1030,7 +1070,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 			},
 		},
 		"in": {
-			Description: "",
+			Description: "Returns true if the first argument is equal to any element of the second argument, or null if there's no match but the second argument contains nulls.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
@@ -1040,16 +1080,11 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if ts[1].TypeID != octosql.TypeIDList {
 							return octosql.Type{}, false
 						}
-						return octosql.Boolean, true
+						return inOutputType(*ts[1].List.Element), true
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						for i := range values[1].List {
-							if values[0].Equal(values[1].List[i]) {
-								return octosql.NewBoolean(true), nil
-							}
-						}
-						return octosql.NewBoolean(false), nil
+						return in(values[0], values[1].List), nil
 					},
 				},
 				{
@@ -1060,22 +1095,17 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if ts[1].TypeID != octosql.TypeIDTuple {
 							return octosql.Type{}, false
 						}
-						return octosql.Boolean, true
+						return inOutputType(ts[1].Tuple.Elements...), true
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						for i := range values[1].Tuple {
-							if values[0].Equal(values[1].Tuple[i]) {
-								return octosql.NewBoolean(true), nil
-							}
-						}
-						return octosql.NewBoolean(false), nil
+						return in(values[0], values[1].Tuple), nil
 					},
 				},
 			},
 		},
 		"not in": {
-			Description: "",
+			Description: "Returns true if the first argument is not equal to any element of the second argument, or null if there's no match but the second argument contains nulls.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
@@ -1085,16 +1115,11 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if ts[1].TypeID != octosql.TypeIDList {
 							return octosql.Type{}, false
 						}
-						return octosql.Boolean, true
+						return inOutputType(*ts[1].List.Element), true
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						for i := range values[1].List {
-							if values[0].Equal(values[1].List[i]) {
-								return octosql.NewBoolean(false), nil
-							}
-						}
-						return octosql.NewBoolean(true), nil
+						return not(in(values[0], values[1].List)), nil
 					},
 				},
 				{
@@ -1105,16 +1130,11 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if ts[1].TypeID != octosql.TypeIDTuple {
 							return octosql.Type{}, false
 						}
-						return octosql.Boolean, true
+						return inOutputType(ts[1].Tuple.Elements...), true
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						for i := range values[1].Tuple {
-							if values[0].Equal(values[1].Tuple[i]) {
-								return octosql.NewBoolean(false), nil
-							}
-						}
-						return octosql.NewBoolean(true), nil
+						return not(in(values[0], values[1].Tuple)), nil
 					},
 				},
 			},
@@ -1135,3 +1155,37 @@ func FunctionMap() map[string]physical.FunctionDetails {
 		},
 	}
 }
+
+// in checks if the value is equal to any of the elements, following the SQL semantics of NULL elements.
+func in(value octosql.Value, elements []octosql.Value) octosql.Value {
+	sawNull := false
+	for i := range elements {
+		if elements[i].TypeID == octosql.TypeIDNull {
+			sawNull = true
+			continue
+		}
+		if value.Equal(elements[i]) {
+			return octosql.NewBoolean(true)
+		}
+	}
+	if sawNull {
+		return octosql.NewNull()
+	}
+	return octosql.NewBoolean(false)
+}
+
+func not(value octosql.Value) octosql.Value {
+	if value.TypeID == octosql.TypeIDNull {
+		return value
+	}
+	return octosql.NewBoolean(!value.Boolean)
+}
+
+func inOutputType(elementTypes ...octosql.Type) octosql.Type {
+	for _, t := range elementTypes {
+		if octosql.Null.Is(t) != octosql.TypeRelationIsnt {
+			return octosql.TypeSum(octosql.Boolean, octosql.Null)
+		}
+	}
+	return octosql.Boolean
+}
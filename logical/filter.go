@@ -18,20 +18,113 @@ func NewFilter(predicate Expression, child Node) *Filter {
 
 func (node *Filter) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
-	predicate := TypecheckExpression(
-		ctx,
-		env.WithRecordSchema(source.Schema),
-		logicalEnv.WithRecordUniqueVariableNames(mapping),
-		octosql.TypeSum(octosql.Boolean, octosql.Null),
-		node.predicate,
-	)
-
-	return physical.Node{
-		Schema:   source.Schema,
-		NodeType: physical.NodeTypeFilter,
-		Filter: &physical.Filter{
-			Source:    source,
-			Predicate: predicate,
+	recordEnv := env.WithRecordSchema(source.Schema)
+	recordLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	// Uncorrelated IN and NOT IN subqueries in the top-level conjunction get turned into semi and anti joins.
+	var semiJoins []*physical.SemiJoin
+	var remaining []Expression
+	for _, conjunct := range splitByAnd(node.predicate) {
+		if semiJoin, ok := typecheckSemiJoin(ctx, recordEnv, recordLogicalEnv, conjunct); ok {
+			semiJoins = append(semiJoins, semiJoin)
+			continue
+		}
+		remaining = append(remaining, conjunct)
+	}
+
+	out := source
+	if len(remaining) > 0 {
+		predicate := remaining[0]
+		for _, conjunct := range remaining[1:] {
+			predicate = NewAnd(predicate, conjunct)
+		}
+
+		out = physical.Node{
+			Schema:   source.Schema,
+			NodeType: physical.NodeTypeFilter,
+			Filter: &physical.Filter{
+				Source:    source,
+				Predicate: TypecheckExpression(ctx, recordEnv, recordLogicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), predicate),
+			},
+		}
+	}
+
+	for _, semiJoin := range semiJoins {
+		semiJoin.Left = out
+		out = physical.Node{
+			Schema: physical.NewSchema(
+				source.Schema.Fields,
+				source.Schema.TimeField,
+				physical.WithNoRetractions(source.Schema.NoRetractions && semiJoin.Right.Schema.NoRetractions && !semiJoin.IsAnti),
+			),
+			NodeType: physical.NodeTypeSemiJoin,
+			SemiJoin: semiJoin,
+		}
+	}
+
+	return out, mapping
+}
+
+func splitByAnd(expr Expression) []Expression {
+	and, ok := expr.(*And)
+	if !ok {
+		return []Expression{expr}
+	}
+	return append(splitByAnd(and.left), splitByAnd(and.right)...)
+}
+
+// typecheckSemiJoin returns a semi join without the left side set if the predicate is an uncorrelated IN or NOT IN subquery.
+func typecheckSemiJoin(ctx context.Context, env physical.Environment, logicalEnv Environment, predicate Expression) (*physical.SemiJoin, bool) {
+	function, ok := predicate.(*FunctionExpression)
+	if !ok || (function.Name != "in" && function.Name != "not in") || len(function.Arguments) != 2 {
+		return nil, false
+	}
+	query, ok := function.Arguments[1].(*QueryExpression)
+	if !ok || query.mode != physical.QueryExpressionModeList {
+		return nil, false
+	}
+
+	subquery, _ := query.node.Typecheck(ctx, env, logicalEnv)
+	if len(subquery.Schema.Fields) != 1 || usesVariablesFromContext(subquery, env.VariableContext) {
+		return nil, false
+	}
+
+	return &physical.SemiJoin{
+		Right:   subquery,
+		LeftKey: []physical.Expression{function.Arguments[0].Typecheck(ctx, env, logicalEnv)},
+		RightKey: []physical.Expression{
+			{
+				Type:           subquery.Schema.Fields[0].Type,
+				ExpressionType: physical.ExpressionTypeVariable,
+				Variable: &physical.Variable{
+					Name:     subquery.Schema.Fields[0].Name,
+					IsLevel0: true,
+				},
+			},
 		},
-	}, mapping
+		IsAnti: function.Name == "not in",
+	}, true
+}
+
+// usesVariablesFromContext checks if the node is correlated with any of the records in the variable context.
+func usesVariablesFromContext(node physical.Node, varCtx *physical.VariableContext) bool {
+	used := false
+	usageChecker := physical.Transformers{
+		ExpressionTransformer: func(expr physical.Expression) physical.Expression {
+			if expr.ExpressionType != physical.ExpressionTypeVariable {
+				return expr
+			}
+			for curCtx := varCtx; curCtx != nil; curCtx = curCtx.Parent {
+				for _, field := range curCtx.Fields {
+					if field.Name == expr.Variable.Name {
+						used = true
+					}
+				}
+			}
+			return expr
+		},
+	}
+	usageChecker.TransformNode(node)
+
+	return used
 }
@@ -38,7 +38,19 @@ func NewDelayTrigger(delay Expression) *DelayTrigger {
 }
 
 func (w *DelayTrigger) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment, keyTimeIndex int) physical.Trigger {
-	panic("implement me")
+	delay := TypecheckExpression(ctx, env, logicalEnv, octosql.Duration, w.Delay)
+	if delay.ExpressionType != physical.ExpressionTypeConstant {
+		panic(fmt.Errorf("delay trigger parameter must be a constant duration"))
+	}
+	if delay.Constant.Value.Duration <= 0 {
+		panic(fmt.Errorf("delay trigger parameter must be a positive duration, is: %s", delay.Constant.Value.Duration))
+	}
+	return physical.Trigger{
+		TriggerType: physical.TriggerTypeDelay,
+		DelayTrigger: &physical.DelayTrigger{
+			Delay: delay.Constant.Value.Duration,
+		},
+	}
 }
 
 type WatermarkTrigger struct {
@@ -83,11 +95,15 @@ type GroupBy struct {
 	aggregates     []string
 	aggregateNames []string
 
+	// groupingSets lists the key indices of each grouping set, or is nil for a plain group by.
+	groupingSets    [][]int
+	groupingSetName string
+
 	triggers []Trigger
 }
 
-func NewGroupBy(source Node, key []Expression, keyNames []string, expressions []Expression, aggregates []string, aggregateNames []string, triggers []Trigger) *GroupBy {
-	return &GroupBy{source: source, key: key, keyNames: keyNames, expressions: expressions, aggregates: aggregates, aggregateNames: aggregateNames, triggers: triggers}
+func NewGroupBy(source Node, key []Expression, keyNames []string, expressions []Expression, aggregates []string, aggregateNames []string, groupingSets [][]int, groupingSetName string, triggers []Trigger) *GroupBy {
+	return &GroupBy{source: source, key: key, keyNames: keyNames, expressions: expressions, aggregates: aggregates, aggregateNames: aggregateNames, groupingSets: groupingSets, groupingSetName: groupingSetName, triggers: triggers}
 }
 
 func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
@@ -106,69 +122,26 @@ func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, lo
 		}
 	}
 
+	// Key fields which are missing from any grouping set may be NULL.
+	keyTypes := make([]octosql.Type, len(key))
+	for i := range key {
+		keyTypes[i] = key[i].Type
+		if node.groupingSets != nil && !allGroupingSetsContain(node.groupingSets, i) {
+			keyTypes[i] = octosql.TypeSum(keyTypes[i], octosql.Null)
+			if keyEventTimeIndex == i {
+				keyEventTimeIndex = -1
+			}
+		}
+	}
+
 	expressions := make([]physical.Expression, len(node.expressions))
 	for i := range node.expressions {
 		expressions[i] = node.expressions[i].Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping))
 	}
 
 	aggregates := make([]physical.Aggregate, len(node.aggregates))
-aggregateLoop:
 	for i, aggname := range node.aggregates {
-		details := env.Aggregates[aggname]
-		for _, descriptor := range details.Descriptors {
-			if descriptor.TypeFn != nil {
-				if outputType, ok := descriptor.TypeFn(expressions[i].Type); ok {
-					if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-						outputType = octosql.TypeSum(outputType, octosql.Null)
-					}
-
-					aggregates[i] = physical.Aggregate{
-						Name:                node.aggregates[i],
-						OutputType:          outputType,
-						AggregateDescriptor: descriptor,
-					}
-					continue aggregateLoop
-				}
-			} else if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
-		}
-		for _, descriptor := range details.Descriptors {
-			if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
-				assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expressions[i].Type)
-				expressions[i] = physical.Expression{
-					ExpressionType: physical.ExpressionTypeTypeAssertion,
-					Type:           assertedExprType,
-					TypeAssertion: &physical.TypeAssertion{
-						Expression: expressions[i],
-						TargetType: descriptor.ArgumentType,
-					},
-				}
-
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
-		}
-		panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expressions[i].Type))
+		aggregates[i], expressions[i] = typecheckAggregate(env, aggname, expressions[i])
 	}
 
 	triggers := make([]physical.Trigger, len(node.triggers))
@@ -192,23 +165,31 @@ aggregateLoop:
 		}
 	}
 
-	schemaFields := make([]physical.SchemaField, len(key)+len(aggregates))
+	schemaFields := make([]physical.SchemaField, 0, len(key)+1+len(aggregates))
 	outMapping := make(map[string]string)
 	for i := range key {
 		unique := logicalEnv.GetUnique(node.keyNames[i])
 		outMapping[node.keyNames[i]] = unique
-		schemaFields[i] = physical.SchemaField{
+		schemaFields = append(schemaFields, physical.SchemaField{
 			Name: unique,
-			Type: key[i].Type,
-		}
+			Type: keyTypes[i],
+		})
+	}
+	if node.groupingSets != nil {
+		unique := logicalEnv.GetUnique(node.groupingSetName)
+		outMapping[node.groupingSetName] = unique
+		schemaFields = append(schemaFields, physical.SchemaField{
+			Name: unique,
+			Type: octosql.Int,
+		})
 	}
 	for i := range aggregates {
 		unique := logicalEnv.GetUnique(node.aggregateNames[i])
 		outMapping[node.aggregateNames[i]] = unique
-		schemaFields[len(key)+i] = physical.SchemaField{
+		schemaFields = append(schemaFields, physical.SchemaField{
 			Name: unique,
 			Type: aggregates[i].OutputType,
-		}
+		})
 	}
 
 	return physical.Node{
@@ -221,6 +202,77 @@ aggregateLoop:
 			Key:                  key,
 			KeyEventTimeIndex:    keyEventTimeIndex,
 			Trigger:              trigger,
+			GroupingSets:         node.groupingSets,
 		},
 	}, outMapping
 }
+
+func allGroupingSetsContain(groupingSets [][]int, keyIndex int) bool {
+setLoop:
+	for _, keyIndices := range groupingSets {
+		for _, index := range keyIndices {
+			if index == keyIndex {
+				continue setLoop
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// typecheckAggregate picks the aggregate descriptor matching the argument type.
+// The argument expression may get wrapped in a type assertion, so it's returned as well.
+func typecheckAggregate(env physical.Environment, aggname string, expression physical.Expression) (physical.Aggregate, physical.Expression) {
+	details := env.Aggregates[aggname]
+	for _, descriptor := range details.Descriptors {
+		if descriptor.TypeFn != nil {
+			if outputType, ok := descriptor.TypeFn(expression.Type); ok {
+				if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+					outputType = octosql.TypeSum(outputType, octosql.Null)
+				}
+
+				return physical.Aggregate{
+					Name:                aggname,
+					OutputType:          outputType,
+					AggregateDescriptor: descriptor,
+				}, expression
+			}
+		} else if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	for _, descriptor := range details.Descriptors {
+		if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
+			assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expression.Type)
+			expression = physical.Expression{
+				ExpressionType: physical.ExpressionTypeTypeAssertion,
+				Type:           assertedExprType,
+				TypeAssertion: &physical.TypeAssertion{
+					Expression: expression,
+					TargetType: descriptor.ArgumentType,
+				},
+			}
+
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expression.Type))
+}
@@ -76,7 +76,8 @@ func (node *OuterJoin) Typecheck(ctx context.Context, env physical.Environment,
 
 	predicate := node.predicate.Typecheck(ctx, env.WithRecordSchema(right.Schema).WithRecordSchema(left.Schema), logicalEnv.WithRecordUniqueVariableNames(outMapping))
 
-	filterPredicates := predicate.SplitByAnd()
+	// Predicates bounding the difference of the time fields of both sides are checked by the join itself, which lets it evict old records.
+	timeBound, filterPredicates := optimizer.ExtractJoinTimeBound(predicate.SplitByAnd(), left.Schema, right.Schema)
 	var leftKey, rightKey []physical.Expression
 
 	for i := range filterPredicates {
@@ -131,12 +132,13 @@ func (node *OuterJoin) Typecheck(ctx context.Context, env physical.Environment,
 		},
 		NodeType: physical.NodeTypeOuterJoin,
 		OuterJoin: &physical.OuterJoin{
-			Left:     left,
-			Right:    right,
-			LeftKey:  leftKey,
-			RightKey: rightKey,
-			IsLeft:   node.isLeft,
-			IsRight:  node.isRight,
+			Left:      left,
+			Right:     right,
+			LeftKey:   leftKey,
+			RightKey:  rightKey,
+			IsLeft:    node.isLeft,
+			IsRight:   node.isRight,
+			TimeBound: timeBound,
 		},
 	}, outMapping
 }
@@ -31,13 +31,15 @@ type OrderSensitiveTransform struct {
 	orderByKeyExprs   []Expression
 	orderByDirections []OrderDirection
 	limit             *Expression
+	offset            *Expression
 }
 
-func NewOrderSensitiveTransform(keyExprs []Expression, directions []OrderDirection, limit *Expression, source Node) *OrderSensitiveTransform {
+func NewOrderSensitiveTransform(keyExprs []Expression, directions []OrderDirection, limit *Expression, offset *Expression, source Node) *OrderSensitiveTransform {
 	return &OrderSensitiveTransform{
 		orderByKeyExprs:   keyExprs,
 		orderByDirections: directions,
 		limit:             limit,
+		offset:            offset,
 		source:            source,
 	}
 }
@@ -58,6 +60,12 @@ func (node *OrderSensitiveTransform) Typecheck(ctx context.Context, env physical
 		limit = &expr
 	}
 
+	var offset *physical.Expression
+	if node.offset != nil {
+		expr := TypecheckExpression(ctx, env, logicalEnv, octosql.Int, *node.offset)
+		offset = &expr
+	}
+
 	return physical.Node{
 		Schema:   physical.NewSchema(source.Schema.Fields, source.Schema.TimeField, physical.WithNoRetractions(true)),
 		NodeType: physical.NodeTypeOrderSensitiveTransform,
@@ -66,6 +74,7 @@ func (node *OrderSensitiveTransform) Typecheck(ctx context.Context, env physical
 			OrderByKey:                  orderByKeyExprs,
 			OrderByDirectionMultipliers: orderByDirectionMultipliers,
 			Limit:                       limit,
+			Offset:                      offset,
 		},
 	}, mapping
 }
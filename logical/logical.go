@@ -95,7 +95,7 @@ func NewDataSource(name, alias string, options map[string]string) *DataSource {
 
 func (ds *DataSource) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	if cte, ok := logicalEnv.CommonTableExpressions[ds.name]; ok {
-		return cte.Node, cte.UniqueVariableMapping
+		return cte.Node, requalifyMapping(ds.alias, cte.UniqueVariableMapping)
 	}
 
 	if ds.name == "dual" {
@@ -312,14 +312,35 @@ func (or *Or) Typecheck(ctx context.Context, env physical.Environment, logicalEn
 
 type QueryExpression struct {
 	node Node
+	mode physical.QueryExpressionMode
 }
 
 func NewQueryExpression(node Node) *QueryExpression {
-	return &QueryExpression{node: node}
+	return &QueryExpression{node: node, mode: physical.QueryExpressionModeList}
+}
+
+// NewExistsQueryExpression creates a query expression which checks whether the query returns any records.
+func NewExistsQueryExpression(node Node, not bool) *QueryExpression {
+	mode := physical.QueryExpressionModeExists
+	if not {
+		mode = physical.QueryExpressionModeNotExists
+	}
+	return &QueryExpression{node: node, mode: mode}
 }
 
 func (ne *QueryExpression) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
 	source, mapping := ne.node.Typecheck(ctx, env, logicalEnv)
+	if ne.mode != physical.QueryExpressionModeList {
+		return physical.Expression{
+			Type:           octosql.Boolean,
+			ExpressionType: physical.ExpressionTypeQueryExpression,
+			QueryExpression: &physical.QueryExpression{
+				Source: source,
+				Mode:   ne.mode,
+			},
+		}
+	}
+
 	reverseMapping := ReverseMapping(mapping)
 
 	var elementType octosql.Type
@@ -341,6 +362,7 @@ func (ne *QueryExpression) Typecheck(ctx context.Context, env physical.Environme
 		ExpressionType: physical.ExpressionTypeQueryExpression,
 		QueryExpression: &physical.QueryExpression{
 			Source: source,
+			Mode:   physical.QueryExpressionModeList,
 		},
 	}
 }
@@ -377,6 +399,47 @@ func (c *Coalesce) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+type Case struct {
+	conditions []Expression
+	results    []Expression
+	elseResult Expression
+}
+
+func NewCase(conditions []Expression, results []Expression, elseResult Expression) *Case {
+	return &Case{conditions: conditions, results: results, elseResult: elseResult}
+}
+
+func (c *Case) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	if len(c.conditions) == 0 {
+		panic("CASE must be provided at least 1 WHEN branch")
+	}
+
+	conditions := make([]physical.Expression, len(c.conditions))
+	for i := range c.conditions {
+		conditions[i] = TypecheckExpression(ctx, env, logicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), c.conditions[i])
+	}
+	results := make([]physical.Expression, len(c.results))
+	for i := range c.results {
+		results[i] = c.results[i].Typecheck(ctx, env, logicalEnv)
+	}
+	elseResult := c.elseResult.Typecheck(ctx, env, logicalEnv)
+
+	outputType := elseResult.Type
+	for _, expr := range results {
+		outputType = octosql.TypeSum(outputType, expr.Type)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeCase,
+		Case: &physical.Case{
+			Conditions: conditions,
+			Results:    results,
+			Else:       elseResult,
+		},
+	}
+}
+
 type TypeCast struct {
 	arg          Expression
 	targetTypeID octosql.TypeID
@@ -0,0 +1,121 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// maxRecursiveCTETypecheckPasses bounds the number of times the recursive part gets typechecked
+// while widening the field types of a recursive common table expression.
+const maxRecursiveCTETypecheckPasses = 10
+
+type RecursiveCTE struct {
+	name      string
+	columns   []string
+	anchor    Node
+	recursive Node
+	distinct  bool
+}
+
+func NewRecursiveCTE(name string, columns []string, anchor, recursive Node, distinct bool) *RecursiveCTE {
+	return &RecursiveCTE{
+		name:      name,
+		columns:   columns,
+		anchor:    anchor,
+		recursive: recursive,
+		distinct:  distinct,
+	}
+}
+
+func (node *RecursiveCTE) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	anchor, anchorMapping := node.anchor.Typecheck(ctx, env, logicalEnv)
+
+	// Column names are taken from the column list, or from the anchor part.
+	columns := node.columns
+	if len(columns) == 0 {
+		anchorReverseMapping := ReverseMapping(anchorMapping)
+		columns = make([]string, len(anchor.Schema.Fields))
+		for i := range anchor.Schema.Fields {
+			columns[i] = anchorReverseMapping[anchor.Schema.Fields[i].Name]
+		}
+	} else if len(columns) != len(anchor.Schema.Fields) {
+		panic(fmt.Errorf("common table expression %s has %d columns, but %d column names were specified", node.name, len(anchor.Schema.Fields), len(columns)))
+	}
+
+	outMapping := make(map[string]string)
+	outFields := make([]physical.SchemaField, len(anchor.Schema.Fields))
+	for i := range anchor.Schema.Fields {
+		unique := logicalEnv.GetUnique(columns[i])
+		outMapping[columns[i]] = unique
+		outFields[i] = physical.SchemaField{
+			Name: unique,
+			Type: anchor.Schema.Fields[i].Type,
+		}
+	}
+	// The working table name ties the references in the recursive part to this node.
+	workingTableName := logicalEnv.GetUnique(node.name)
+
+	// The recursive part may produce records of a wider type than the anchor part,
+	// so we widen the field types until they stop changing.
+	var recursive physical.Node
+	for pass := 0; ; pass++ {
+		if pass == maxRecursiveCTETypecheckPasses {
+			panic(fmt.Errorf("couldn't infer the column types of recursive common table expression %s", node.name))
+		}
+
+		newCTEs := make(map[string]CommonTableExpression)
+		for k, v := range logicalEnv.CommonTableExpressions {
+			newCTEs[k] = v
+		}
+		newCTEs[node.name] = CommonTableExpression{
+			Node: physical.Node{
+				Schema:   physical.NewSchema(outFields, -1, physical.WithNoRetractions(true)),
+				NodeType: physical.NodeTypeRecursiveCTEReference,
+				RecursiveCTEReference: &physical.RecursiveCTEReference{
+					Name: workingTableName,
+				},
+			},
+			UniqueVariableMapping: outMapping,
+		}
+
+		recursive, _ = node.recursive.Typecheck(ctx, env, Environment{
+			CommonTableExpressions: newCTEs,
+			TableValuedFunctions:   logicalEnv.TableValuedFunctions,
+			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
+			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
+		})
+		if len(recursive.Schema.Fields) != len(outFields) {
+			panic(fmt.Errorf("recursive common table expression %s parts must have the same number of columns, got %d and %d", node.name, len(outFields), len(recursive.Schema.Fields)))
+		}
+
+		changed := false
+		newFields := make([]physical.SchemaField, len(outFields))
+		for i := range outFields {
+			newFields[i] = physical.SchemaField{
+				Name: outFields[i].Name,
+				Type: octosql.TypeSum(outFields[i].Type, recursive.Schema.Fields[i].Type),
+			}
+			if !newFields[i].Type.Equals(outFields[i].Type) {
+				changed = true
+			}
+		}
+		outFields = newFields
+		if !changed {
+			break
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, -1, physical.WithNoRetractions(true)),
+		NodeType: physical.NodeTypeRecursiveCTE,
+		RecursiveCTE: &physical.RecursiveCTE{
+			Name:      workingTableName,
+			Anchor:    anchor,
+			Recursive: recursive,
+			Distinct:  node.distinct,
+		},
+	}, outMapping
+}
@@ -0,0 +1,51 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/physical"
+)
+
+// SetOperation is an INTERSECT or EXCEPT operation.
+type SetOperation struct {
+	first, second Node
+	operationType physical.SetOperationType
+	distinct      bool
+}
+
+func NewIntersect(first, second Node, distinct bool) *SetOperation {
+	return &SetOperation{first: first, second: second, operationType: physical.SetOperationTypeIntersectAll, distinct: distinct}
+}
+
+func NewExcept(first, second Node, distinct bool) *SetOperation {
+	return &SetOperation{first: first, second: second, operationType: physical.SetOperationTypeExceptAll, distinct: distinct}
+}
+
+func (node *SetOperation) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	firstNode, secondNode := node.first, node.second
+	if node.distinct {
+		// Deduplicating both sources makes each record present at most once in the output.
+		firstNode, secondNode = NewDistinct(firstNode), NewDistinct(secondNode)
+	}
+	first, firstMapping := firstNode.Typecheck(ctx, env, logicalEnv)
+	second, _ := secondNode.Typecheck(ctx, env, logicalEnv)
+
+	operation := "intersect"
+	if node.operationType == physical.SetOperationTypeExceptAll {
+		operation = "except"
+	}
+	outFields, outTimeField, outMapping := typecheckSetOperationSchema(logicalEnv, operation, first, firstMapping, second)
+
+	// Records of the right source decrease the output of EXCEPT, so it may retract records.
+	noRetractions := first.Schema.NoRetractions && second.Schema.NoRetractions && node.operationType != physical.SetOperationTypeExceptAll
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, outTimeField, physical.WithNoRetractions(noRetractions)),
+		NodeType: physical.NodeTypeSetOperation,
+		SetOperation: &physical.SetOperation{
+			Left:  first,
+			Right: second,
+			Type:  node.operationType,
+		},
+	}, outMapping
+}
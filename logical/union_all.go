@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -15,5 +17,45 @@ func NewUnionAll(first, second Node) *UnionAll {
 }
 
 func (node *UnionAll) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
-	panic("implement me")
+	first, firstMapping := node.first.Typecheck(ctx, env, logicalEnv)
+	second, _ := node.second.Typecheck(ctx, env, logicalEnv)
+
+	outFields, outTimeField, outMapping := typecheckSetOperationSchema(logicalEnv, "union", first, firstMapping, second)
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, outTimeField, physical.WithNoRetractions(first.Schema.NoRetractions && second.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeUnionAll,
+		UnionAll: &physical.UnionAll{
+			Left:  first,
+			Right: second,
+		},
+	}, outMapping
+}
+
+// typecheckSetOperationSchema creates the output schema of an operation combining the records of both nodes positionally.
+func typecheckSetOperationSchema(logicalEnv Environment, operation string, first physical.Node, firstMapping map[string]string, second physical.Node) ([]physical.SchemaField, int, map[string]string) {
+	if len(first.Schema.Fields) != len(second.Schema.Fields) {
+		panic(fmt.Errorf("%s branches must have the same number of columns, got %d and %d", operation, len(first.Schema.Fields), len(second.Schema.Fields)))
+	}
+
+	// Column names are taken from the first branch.
+	firstReverseMapping := ReverseMapping(firstMapping)
+	outMapping := make(map[string]string)
+	outFields := make([]physical.SchemaField, len(first.Schema.Fields))
+	for i := range first.Schema.Fields {
+		name := firstReverseMapping[first.Schema.Fields[i].Name]
+		unique := logicalEnv.GetUnique(name)
+		outMapping[name] = unique
+		outFields[i] = physical.SchemaField{
+			Name: unique,
+			Type: octosql.TypeSum(first.Schema.Fields[i].Type, second.Schema.Fields[i].Type),
+		}
+	}
+
+	outTimeField := -1
+	if first.Schema.TimeField != -1 && first.Schema.TimeField == second.Schema.TimeField {
+		outTimeField = first.Schema.TimeField
+	}
+
+	return outFields, outTimeField, outMapping
 }
@@ -15,6 +15,5 @@ func NewUnionDistinct(first, second Node) *UnionDistinct {
 }
 
 func (node *UnionDistinct) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
-	panic("implement me")
-	// return NewDistinct(NewUnionAll(node.first, node.second)).Physical(ctx, physicalCreator)
+	return NewDistinct(NewUnionAll(node.first, node.second)).Typecheck(ctx, env, logicalEnv)
 }
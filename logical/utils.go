@@ -330,6 +330,22 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			return true
 		}
 
+	case *Case:
+		if expr2, ok := expr2.(*Case); ok {
+			if len(expr1.conditions) != len(expr2.conditions) {
+				return false
+			}
+			for i := range expr1.conditions {
+				if !EqualExpressions(expr1.conditions[i], expr2.conditions[i]) {
+					return false
+				}
+				if !EqualExpressions(expr1.results[i], expr2.results[i]) {
+					return false
+				}
+			}
+			return EqualExpressions(expr1.elseResult, expr2.elseResult)
+		}
+
 	case *ObjectFieldAccess:
 		if expr2, ok := expr2.(*ObjectFieldAccess); ok {
 			if expr1.field != expr2.field {
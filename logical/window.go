@@ -0,0 +1,130 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+type Window struct {
+	source            Node
+	partitionBy       []Expression
+	orderByKeyExprs   []Expression
+	orderByDirections []OrderDirection
+
+	functions     []string
+	arguments     [][]Expression
+	functionNames []string
+}
+
+func NewWindow(source Node, partitionBy []Expression, orderByKeyExprs []Expression, orderByDirections []OrderDirection, functions []string, arguments [][]Expression, functionNames []string) *Window {
+	return &Window{
+		source:            source,
+		partitionBy:       partitionBy,
+		orderByKeyExprs:   orderByKeyExprs,
+		orderByDirections: orderByDirections,
+		functions:         functions,
+		arguments:         arguments,
+		functionNames:     functionNames,
+	}
+}
+
+func (node *Window) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+	recordEnv := env.WithRecordSchema(source.Schema)
+	recordLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	partitionBy := make([]physical.Expression, len(node.partitionBy))
+	for i := range node.partitionBy {
+		partitionBy[i] = node.partitionBy[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	orderByKeyExprs := make([]physical.Expression, len(node.orderByKeyExprs))
+	for i := range node.orderByKeyExprs {
+		orderByKeyExprs[i] = node.orderByKeyExprs[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	functions := make([]physical.WindowFunction, len(node.functions))
+	outputTypes := make([]octosql.Type, len(node.functions))
+	for i, name := range node.functions {
+		arguments := node.arguments[i]
+		checkArgumentCount := func(min, max int) {
+			if len(arguments) < min || len(arguments) > max {
+				if min == max {
+					panic(fmt.Errorf("window function %s expects %d arguments, got %d", name, min, len(arguments)))
+				}
+				panic(fmt.Errorf("window function %s expects %d to %d arguments, got %d", name, min, max, len(arguments)))
+			}
+		}
+
+		switch name {
+		case "row_number", "rank", "dense_rank":
+			checkArgumentCount(0, 0)
+			functions[i] = physical.WindowFunction{Name: name}
+			outputTypes[i] = octosql.Int
+
+		case "lag", "lead":
+			checkArgumentCount(1, 3)
+			typecheckedArguments := make([]physical.Expression, len(arguments))
+			typecheckedArguments[0] = arguments[0].Typecheck(ctx, recordEnv, recordLogicalEnv)
+			outputType := octosql.TypeSum(typecheckedArguments[0].Type, octosql.Null)
+			if len(arguments) > 1 {
+				typecheckedArguments[1] = TypecheckExpression(ctx, recordEnv, recordLogicalEnv, octosql.TypeSum(octosql.Int, octosql.Null), arguments[1])
+			}
+			if len(arguments) > 2 {
+				typecheckedArguments[2] = arguments[2].Typecheck(ctx, recordEnv, recordLogicalEnv)
+				outputType = octosql.TypeSum(typecheckedArguments[0].Type, typecheckedArguments[2].Type)
+				if octosql.Null.Is(typecheckedArguments[1].Type) == octosql.TypeRelationIs {
+					// A NULL offset results in NULL.
+					outputType = octosql.TypeSum(outputType, octosql.Null)
+				}
+			}
+			functions[i] = physical.WindowFunction{Name: name, Arguments: typecheckedArguments}
+			outputTypes[i] = outputType
+
+		case "first_value", "last_value":
+			checkArgumentCount(1, 1)
+			argument := arguments[0].Typecheck(ctx, recordEnv, recordLogicalEnv)
+			functions[i] = physical.WindowFunction{Name: name, Arguments: []physical.Expression{argument}}
+			outputTypes[i] = argument.Type
+
+		default:
+			if _, ok := env.Aggregates[name]; !ok {
+				panic(fmt.Errorf("unknown window function: %s", name))
+			}
+			checkArgumentCount(1, 1)
+			aggregate, argument := typecheckAggregate(env, name, arguments[0].Typecheck(ctx, recordEnv, recordLogicalEnv))
+			functions[i] = physical.WindowFunction{Name: name, Arguments: []physical.Expression{argument}, Aggregate: &aggregate}
+			outputTypes[i] = aggregate.OutputType
+		}
+	}
+
+	outMapping := make(map[string]string)
+	for k, v := range mapping {
+		outMapping[k] = v
+	}
+	schemaFields := make([]physical.SchemaField, len(source.Schema.Fields), len(source.Schema.Fields)+len(functions))
+	copy(schemaFields, source.Schema.Fields)
+	for i := range functions {
+		unique := logicalEnv.GetUnique(node.functionNames[i])
+		outMapping[node.functionNames[i]] = unique
+		schemaFields = append(schemaFields, physical.SchemaField{
+			Name: unique,
+			Type: outputTypes[i],
+		})
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(schemaFields, source.Schema.TimeField),
+		NodeType: physical.NodeTypeWindow,
+		Window: &physical.Window{
+			Source:                      source,
+			PartitionBy:                 partitionBy,
+			OrderByKey:                  orderByKeyExprs,
+			OrderByDirectionMultipliers: DirectionsToMultipliers(node.orderByDirections),
+			Functions:                   functions,
+		},
+	}, outMapping
+}
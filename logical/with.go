@@ -2,21 +2,25 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cube2222/octosql/physical"
 )
 
 type With struct {
 	cteNames []string
-	cteNodes []Node
-	source   Node
+	// cteColumns holds the optional column names of each common table expression.
+	cteColumns [][]string
+	cteNodes   []Node
+	source     Node
 }
 
-func NewWith(cteNames []string, cteNodes []Node, source Node) *With {
+func NewWith(cteNames []string, cteColumns [][]string, cteNodes []Node, source Node) *With {
 	return &With{
-		cteNames: cteNames,
-		cteNodes: cteNodes,
-		source:   source,
+		cteNames:   cteNames,
+		cteColumns: cteColumns,
+		cteNodes:   cteNodes,
+		source:     source,
 	}
 }
 
@@ -33,6 +37,9 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
 			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
 		})
+		if len(node.cteColumns[i]) > 0 {
+			mapping = renameColumns(node.cteNames[i], node.cteColumns[i], cte.Schema)
+		}
 		newCTEs[node.cteNames[i]] = CommonTableExpression{
 			Node:                  cte,
 			UniqueVariableMapping: mapping,
@@ -46,3 +53,15 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 		UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
 	})
 }
+
+// renameColumns creates a mapping which names the fields of the schema with the given column names, positionally.
+func renameColumns(cteName string, columns []string, schema physical.Schema) map[string]string {
+	if len(columns) != len(schema.Fields) {
+		panic(fmt.Errorf("common table expression %s has %d columns, but %d column names were specified", cteName, len(schema.Fields), len(columns)))
+	}
+	mapping := make(map[string]string)
+	for i := range columns {
+		mapping[columns[i]] = schema.Fields[i].Name
+	}
+	return mapping
+}
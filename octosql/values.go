@@ -1,7 +1,10 @@
 package octosql
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash"
+	"math"
 	"strings"
 	"time"
 )
@@ -243,6 +246,54 @@ func (value Value) Equal(other Value) bool {
 	return value.Compare(other) == 0
 }
 
+// Hash writes the value to the hash, so that values which Compare as equal get the same hash.
+func (value Value) Hash(h hash.Hash64) {
+	var buf [9]byte
+	buf[0] = byte(value.TypeID)
+	switch value.TypeID {
+	case TypeIDInt:
+		binary.LittleEndian.PutUint64(buf[1:], uint64(value.Int))
+	case TypeIDFloat:
+		f := value.Float
+		if f == 0 {
+			// Negative zero is equal to zero.
+			f = 0
+		}
+		binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(f))
+	case TypeIDBoolean:
+		if value.Boolean {
+			buf[1] = 1
+		}
+	case TypeIDString:
+		h.Write(buf[:1])
+		h.Write([]byte(value.Str))
+		// Separates the string from the following values.
+		binary.LittleEndian.PutUint64(buf[1:], uint64(len(value.Str)))
+	case TypeIDTime:
+		// Equal times in different locations are equal.
+		binary.LittleEndian.PutUint64(buf[1:], uint64(value.Time.UnixNano()))
+	case TypeIDDuration:
+		binary.LittleEndian.PutUint64(buf[1:], uint64(value.Duration))
+	case TypeIDList, TypeIDStruct, TypeIDTuple:
+		var values []Value
+		switch value.TypeID {
+		case TypeIDList:
+			values = value.List
+		case TypeIDStruct:
+			values = value.Struct
+		case TypeIDTuple:
+			values = value.Tuple
+		}
+		binary.LittleEndian.PutUint64(buf[1:], uint64(len(values)))
+		h.Write(buf[:])
+		for i := range values {
+			values[i].Hash(h)
+		}
+		return
+	}
+	h.Write(buf[:])
+}
+
 func (value Value) Type() Type {
 	switch value.TypeID {
 	case TypeIDList:
@@ -0,0 +1,160 @@
+package optimizer
+
+import (
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+	. "github.com/cube2222/octosql/physical"
+)
+
+// ExtractJoinTimeBound finds the predicates which limit the difference between the time fields of both sides of a join,
+// like a.t BETWEEN b.t - INTERVAL 5 MINUTES AND b.t, and combines them into a single bound.
+// It returns nil if there are none, and the predicates which weren't used.
+func ExtractJoinTimeBound(predicates []Expression, leftSchema, rightSchema Schema) (*JoinTimeBound, []Expression) {
+	if leftSchema.TimeField == -1 || rightSchema.TimeField == -1 {
+		return nil, predicates
+	}
+	leftTimeField := leftSchema.Fields[leftSchema.TimeField].Name
+	rightTimeField := rightSchema.Fields[rightSchema.TimeField].Name
+
+	var bound *JoinTimeBound
+	var remaining []Expression
+	for _, predicate := range predicates {
+		predicateBound, ok := parseJoinTimeBound(predicate, leftTimeField, rightTimeField)
+		if !ok {
+			remaining = append(remaining, predicate)
+			continue
+		}
+		if bound == nil {
+			bound = &JoinTimeBound{}
+		}
+		bound.Lower = tighterLowerLimit(bound.Lower, predicateBound.Lower)
+		bound.Upper = tighterUpperLimit(bound.Upper, predicateBound.Upper)
+	}
+	return bound, remaining
+}
+
+func parseJoinTimeBound(predicate Expression, leftTimeField, rightTimeField string) (*JoinTimeBound, bool) {
+	if predicate.ExpressionType != ExpressionTypeFunctionCall {
+		return nil, false
+	}
+	args := predicate.FunctionCall.Arguments
+
+	switch predicate.FunctionCall.Name {
+	case "between":
+		lower, ok := parseJoinTimeBoundComparison(">=", args[0], args[1], leftTimeField, rightTimeField)
+		if !ok {
+			return nil, false
+		}
+		upper, ok := parseJoinTimeBoundComparison("<=", args[0], args[2], leftTimeField, rightTimeField)
+		if !ok {
+			return nil, false
+		}
+		return &JoinTimeBound{
+			Lower: tighterLowerLimit(lower.Lower, upper.Lower),
+			Upper: tighterUpperLimit(lower.Upper, upper.Upper),
+		}, true
+	case "<", "<=", ">", ">=":
+		return parseJoinTimeBoundComparison(predicate.FunctionCall.Name, args[0], args[1], leftTimeField, rightTimeField)
+	default:
+		return nil, false
+	}
+}
+
+// parseJoinTimeBoundComparison parses a comparison of the form first <operator> second,
+// where one side is the left time field and the other is the right time field, each optionally shifted by a constant interval.
+func parseJoinTimeBoundComparison(operator string, first, second Expression, leftTimeField, rightTimeField string) (*JoinTimeBound, bool) {
+	firstField, firstOffset, ok := parseShiftedVariable(first)
+	if !ok {
+		return nil, false
+	}
+	secondField, secondOffset, ok := parseShiftedVariable(second)
+	if !ok {
+		return nil, false
+	}
+
+	// first + firstOffset <operator> second + secondOffset
+	var difference time.Duration
+	if VariableNameMatchesField(firstField, leftTimeField) && VariableNameMatchesField(secondField, rightTimeField) {
+		// left - right <operator> secondOffset - firstOffset
+		difference = secondOffset - firstOffset
+	} else if VariableNameMatchesField(firstField, rightTimeField) && VariableNameMatchesField(secondField, leftTimeField) {
+		// left - right <flipped operator> firstOffset - secondOffset
+		difference = firstOffset - secondOffset
+		switch operator {
+		case "<":
+			operator = ">"
+		case "<=":
+			operator = ">="
+		case ">":
+			operator = "<"
+		case ">=":
+			operator = "<="
+		}
+	} else {
+		return nil, false
+	}
+
+	limit := &JoinTimeBoundLimit{
+		Difference: difference,
+		Inclusive:  operator == "<=" || operator == ">=",
+	}
+	if operator == "<" || operator == "<=" {
+		return &JoinTimeBound{Upper: limit}, true
+	}
+	return &JoinTimeBound{Lower: limit}, true
+}
+
+// parseShiftedVariable parses expressions of the form variable, variable + interval and variable - interval.
+func parseShiftedVariable(expr Expression) (string, time.Duration, bool) {
+	switch expr.ExpressionType {
+	case ExpressionTypeVariable:
+		return expr.Variable.Name, 0, true
+	case ExpressionTypeFunctionCall:
+		if expr.FunctionCall.Name != "+" && expr.FunctionCall.Name != "-" || len(expr.FunctionCall.Arguments) != 2 {
+			return "", 0, false
+		}
+		variable, offset := expr.FunctionCall.Arguments[0], expr.FunctionCall.Arguments[1]
+		if expr.FunctionCall.Name == "+" && variable.ExpressionType == ExpressionTypeConstant {
+			variable, offset = offset, variable
+		}
+		if variable.ExpressionType != ExpressionTypeVariable {
+			return "", 0, false
+		}
+		if offset.ExpressionType != ExpressionTypeConstant || offset.Constant.Value.TypeID != octosql.TypeIDDuration {
+			return "", 0, false
+		}
+		if expr.FunctionCall.Name == "-" {
+			return variable.Variable.Name, -offset.Constant.Value.Duration, true
+		}
+		return variable.Variable.Name, offset.Constant.Value.Duration, true
+	default:
+		return "", 0, false
+	}
+}
+
+func tighterLowerLimit(a, b *JoinTimeBoundLimit) *JoinTimeBoundLimit {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Difference > b.Difference {
+		return a
+	}
+	if b.Difference > a.Difference {
+		return b
+	}
+	return &JoinTimeBoundLimit{Difference: a.Difference, Inclusive: a.Inclusive && b.Inclusive}
+}
+
+func tighterUpperLimit(a, b *JoinTimeBoundLimit) *JoinTimeBoundLimit {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Difference < b.Difference {
+		return a
+	}
+	if b.Difference < a.Difference {
+		return b
+	}
+	return &JoinTimeBoundLimit{Difference: a.Difference, Inclusive: a.Inclusive && b.Inclusive}
+}
@@ -10,6 +10,7 @@ var defaultOptimizationRules = []func(Node) (output Node, changed bool){
 	PushDownFilterPredicatesIntoLookupJoinBranch,
 	PushDownFilterPredicatesIntoStreamJoinBranch,
 	PushDownFilterPredicatesIntoStreamJoinKey,
+	PushDownFilterTimeBoundIntoStreamJoin,
 	RemoveUnusedMapFields,
 	RemoveUnusedGroupByNonKeyFields,
 	RemoveUnusedDatasourceFields,
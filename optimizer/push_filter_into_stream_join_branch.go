@@ -83,10 +83,11 @@ func PushDownFilterPredicatesIntoStreamJoinBranch(node Node) (Node, bool) {
 				Schema:   node.Filter.Source.Schema,
 				NodeType: NodeTypeStreamJoin,
 				StreamJoin: &StreamJoin{
-					LeftKey:  node.Filter.Source.StreamJoin.LeftKey,
-					RightKey: node.Filter.Source.StreamJoin.RightKey,
-					Left:     joinSourceLeft,
-					Right:    joinSourceRight,
+					LeftKey:   node.Filter.Source.StreamJoin.LeftKey,
+					RightKey:  node.Filter.Source.StreamJoin.RightKey,
+					Left:      joinSourceLeft,
+					Right:     joinSourceRight,
+					TimeBound: node.Filter.Source.StreamJoin.TimeBound,
 				},
 			}
 			if len(stayedAbove) > 0 {
@@ -61,10 +61,11 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 				Schema:   node.Filter.Source.Schema,
 				NodeType: NodeTypeStreamJoin,
 				StreamJoin: &StreamJoin{
-					LeftKey:  append(node.Filter.Source.StreamJoin.LeftKey, leftKeyAdd...),
-					RightKey: append(node.Filter.Source.StreamJoin.RightKey, rightKeyAdd...),
-					Left:     node.Filter.Source.StreamJoin.Left,
-					Right:    node.Filter.Source.StreamJoin.Right,
+					LeftKey:   append(node.Filter.Source.StreamJoin.LeftKey, leftKeyAdd...),
+					RightKey:  append(node.Filter.Source.StreamJoin.RightKey, rightKeyAdd...),
+					Left:      node.Filter.Source.StreamJoin.Left,
+					Right:     node.Filter.Source.StreamJoin.Right,
+					TimeBound: node.Filter.Source.StreamJoin.TimeBound,
 				},
 			}
 			if len(stayedAbove) > 0 {
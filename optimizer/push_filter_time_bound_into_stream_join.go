@@ -0,0 +1,57 @@
+package optimizer
+
+import (
+	"reflect"
+
+	. "github.com/cube2222/octosql/physical"
+)
+
+// PushDownFilterTimeBoundIntoStreamJoin lets a stream join know about the filter predicates bounding the difference of the time fields of its sides,
+// so that it can evict records which can't be joined anymore.
+// The predicates stay in the filter, the join uses them only to limit its state.
+func PushDownFilterTimeBoundIntoStreamJoin(node Node) (Node, bool) {
+	changed := false
+	t := Transformers{
+		NodeTransformer: func(node Node) Node {
+			if node.NodeType != NodeTypeFilter {
+				return node
+			}
+			if node.Filter.Source.NodeType != NodeTypeStreamJoin {
+				return node
+			}
+			join := node.Filter.Source.StreamJoin
+
+			timeBound, _ := ExtractJoinTimeBound(node.Filter.Predicate.SplitByAnd(), join.Left.Schema, join.Right.Schema)
+			if timeBound == nil || reflect.DeepEqual(timeBound, join.TimeBound) {
+				return node
+			}
+			changed = true
+
+			return Node{
+				Schema:   node.Schema,
+				NodeType: NodeTypeFilter,
+				Filter: &Filter{
+					Predicate: node.Filter.Predicate,
+					Source: Node{
+						Schema:   node.Filter.Source.Schema,
+						NodeType: NodeTypeStreamJoin,
+						StreamJoin: &StreamJoin{
+							LeftKey:   join.LeftKey,
+							RightKey:  join.RightKey,
+							Left:      join.Left,
+							Right:     join.Right,
+							TimeBound: timeBound,
+						},
+					},
+				},
+			}
+		},
+	}
+	output := t.TransformNode(node)
+
+	if changed {
+		return output, true
+	} else {
+		return node, false
+	}
+}
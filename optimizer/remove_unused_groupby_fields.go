@@ -27,7 +27,7 @@ func getNonTimeFieldGroupByFields(node Node) []string {
 				return node
 			}
 			for i, field := range node.Schema.Fields {
-				if i < len(node.GroupBy.Key) {
+				if i < node.GroupBy.KeyFieldCount() {
 					continue
 				}
 				if i == node.Schema.TimeField {
@@ -60,7 +60,7 @@ func removeGroupByField(field string, node Node) Node {
 				return node
 			}
 
-			aggregateIndex := index - len(node.GroupBy.Key)
+			aggregateIndex := index - node.GroupBy.KeyFieldCount()
 
 			node.Schema.Fields = append(node.Schema.Fields[:index], node.Schema.Fields[index+1:]...)
 			node.GroupBy.AggregateExpressions = append(node.GroupBy.AggregateExpressions[:aggregateIndex], node.GroupBy.AggregateExpressions[aggregateIndex+1:]...)
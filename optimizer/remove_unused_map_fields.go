@@ -72,15 +72,42 @@ func isUsed(field string, node Node) bool {
 			return expr
 		},
 		NodeTransformer: func(node Node) Node {
-			if node.NodeType != NodeTypeTableValuedFunction {
-				return node
-			}
-			for _, arg := range node.TableValuedFunction.Arguments {
-				if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
-					continue
+			switch node.NodeType {
+			case NodeTypeTableValuedFunction:
+				for _, arg := range node.TableValuedFunction.Arguments {
+					if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
+						continue
+					}
+					if arg.Descriptor.Descriptor == field {
+						used = true
+					}
 				}
-				if arg.Descriptor.Descriptor == field {
-					used = true
+			case NodeTypeUnionAll:
+				// Union branches are matched positionally, so all of their fields are used.
+				for _, branch := range []Node{node.UnionAll.Left, node.UnionAll.Right} {
+					for i := range branch.Schema.Fields {
+						if branch.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
+				}
+			case NodeTypeSetOperation:
+				// Records of both sources are matched by all of their fields, so all of them are used.
+				for _, branch := range []Node{node.SetOperation.Left, node.SetOperation.Right} {
+					for i := range branch.Schema.Fields {
+						if branch.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
+				}
+			case NodeTypeRecursiveCTE:
+				// Recursive common table expression parts are matched positionally, so all of their fields are used.
+				for _, part := range []Node{node.RecursiveCTE.Anchor, node.RecursiveCTE.Recursive} {
+					for i := range part.Schema.Fields {
+						if part.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
 				}
 			}
 
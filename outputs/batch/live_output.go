@@ -25,6 +25,7 @@ type OutputPrinter struct {
 	keyExprs              []Expression
 	directionMultipliers  []int
 	limit                 *int
+	offset                *int
 	noRetractionsPossible bool
 
 	schema physical.Schema
@@ -32,12 +33,13 @@ type OutputPrinter struct {
 	live   bool
 }
 
-func NewOutputPrinter(source Node, keyExprs []Expression, directionMultipliers []int, limit *int, noRetractionsPossible bool, schema physical.Schema, format func(io.Writer) Format, live bool) *OutputPrinter {
+func NewOutputPrinter(source Node, keyExprs []Expression, directionMultipliers []int, limit *int, offset *int, noRetractionsPossible bool, schema physical.Schema, format func(io.Writer) Format, live bool) *OutputPrinter {
 	return &OutputPrinter{
 		source:                source,
 		keyExprs:              keyExprs,
 		directionMultipliers:  directionMultipliers,
 		limit:                 limit,
+		offset:                offset,
 		noRetractionsPossible: noRetractionsPossible,
 		schema:                schema,
 		format:                format,
@@ -82,6 +84,11 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 
 	onlyZeroEventTimesSeen := true
 
+	offset := 0
+	if o.offset != nil {
+		offset = *o.offset
+	}
+
 	printTable := func() {
 		lastUpdate = time.Now()
 		var buf bytes.Buffer
@@ -89,10 +96,16 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 		format := o.format(&buf)
 		format.SetSchema(o.schema)
 
+		// The whole table is recalculated on each print, so rows shifting across the offset boundary because of retractions are handled.
+		skipped := 0
 		i := 0
 		recordCounts.Ascend(func(item btree.Item) bool {
 			itemTyped := item.(*outputItem)
 			for j := 0; j < itemTyped.Count; j++ {
+				if skipped < offset {
+					skipped++
+					continue
+				}
 				if o.limit != nil && i == *o.limit {
 					return false
 				}
@@ -157,7 +170,7 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 			if onlyZeroEventTimesSeen && !record.EventTime.IsZero() {
 				onlyZeroEventTimesSeen = false
 			}
-			if o.limit != nil && o.noRetractionsPossible && recordCounts.Len() > *o.limit {
+			if o.limit != nil && o.noRetractionsPossible && recordCounts.Len() > *o.limit+offset {
 				// This doesn't mean we'll always keep just the records that are needed, because tree nodes might have count > 1.
 				// That said, it's a good approximation, and we'll definitely not lose something that we need to have.
 				recordCounts.DeleteMax()
@@ -183,10 +196,15 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 	var buf bytes.Buffer
 	format := o.format(&buf)
 	format.SetSchema(o.schema)
+	skipped := 0
 	i := 0
 	recordCounts.Ascend(func(item btree.Item) bool {
 		itemTyped := item.(*outputItem)
 		for j := 0; j < itemTyped.Count; j++ {
+			if skipped < offset {
+				skipped++
+				continue
+			}
 			if o.limit != nil && i == *o.limit {
 				return false
 			}
@@ -36,10 +36,16 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 	format := o.format(w)
 	format.SetSchema(o.schema)
 
-	if err := o.source.Run(
+	if err := RunBatch(
+		o.source,
 		execCtx,
-		func(ctx ProduceContext, record Record) error {
-			return format.Write(record.Values)
+		func(ctx ProduceContext, batch *RecordBatch) error {
+			for _, record := range batch.Records {
+				if err := format.Write(record.Values); err != nil {
+					return err
+				}
+			}
+			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error {
 			return nil
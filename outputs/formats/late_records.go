@@ -0,0 +1,48 @@
+package formats
+
+import (
+	"io"
+	"time"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/cube2222/octosql/execution"
+)
+
+// LateRecordsJSONWriter writes the records dropped for arriving too late as JSON objects, one per line.
+// The schema of the node dropping them isn't known, so the values are written as an array.
+type LateRecordsJSONWriter struct {
+	buf   []byte
+	arena *fastjson.Arena
+	w     io.Writer
+}
+
+func NewLateRecordsJSONWriter(w io.Writer) *LateRecordsJSONWriter {
+	return &LateRecordsJSONWriter{
+		buf:   make([]byte, 0, 1024),
+		arena: new(fastjson.Arena),
+		w:     w,
+	}
+}
+
+func (t *LateRecordsJSONWriter) Write(record execution.Record) error {
+	values := t.arena.NewArray()
+	for i := range record.Values {
+		values.SetArrayItem(i, ValueToJson(t.arena, record.Values[i].Type(), record.Values[i]))
+	}
+	obj := t.arena.NewObject()
+	obj.Set("event_time", t.arena.NewString(record.EventTime.Format(time.RFC3339)))
+	if record.Retraction {
+		obj.Set("retraction", t.arena.NewTrue())
+	} else {
+		obj.Set("retraction", t.arena.NewFalse())
+	}
+	obj.Set("values", values)
+
+	t.buf = obj.MarshalTo(t.buf)
+	t.buf = append(t.buf, '\n')
+	_, err := t.w.Write(t.buf)
+	t.buf = t.buf[:0]
+	t.arena.Reset()
+	return err
+}
@@ -15,39 +15,9 @@ import (
 	"github.com/cube2222/octosql/parser/sqlparser"
 )
 
-// func ParseUnion(statement *sqlparser.Union) (logical.Node, error) {
-// 	var err error
-// 	var root logical.Node
-//
-// 	if statement.OrderBy != nil {
-// 		return nil, errors.Errorf("order by is currently unsupported, got %+v", statement)
-// 	}
-//
-// 	firstNode, _, err := ParseNode(statement.Left)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse first select expression")
-// 	}
-//
-// 	secondNode, _, err := ParseNode(statement.Right)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse second select expression")
-// 	}
-// 	switch statement.Type {
-// 	case sqlparser.UnionAllStr:
-// 		root = logical.NewUnionAll(firstNode, secondNode)
-//
-// 	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
-// 		root = logical.NewUnionDistinct(firstNode, secondNode)
-//
-// 	default:
-// 		return nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
-// 	}
-//
-// 	return root, nil
-// }
-
 type OutputOptions struct {
 	Limit              *logical.Expression
+	Offset             *logical.Expression
 	OrderByExpressions []logical.Expression
 	OrderByDirections  []logical.OrderDirection
 }
@@ -88,17 +58,24 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			}
 		}
 	}
+	if statement.Having != nil {
+		isGroupBy = true
+	}
+	for i := range statement.GroupBy {
+		switch statement.GroupBy[i].(type) {
+		case *sqlparser.RollupExpr, *sqlparser.CubeExpr, *sqlparser.GroupingSetsExpr:
+			isGroupBy = true
+		}
+	}
 	if isGroupBy {
-		key := make([]logical.Expression, len(statement.GroupBy))
-		for i := range statement.GroupBy {
-			key[i], err = ParseExpression(statement.GroupBy[i])
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %v", i)
-			}
+		key, groupingSets, err := ParseGroupBy(statement.GroupBy)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		isAggregate := make([]bool, len(statement.SelectExprs))
+		isGrouping := make([]bool, len(statement.SelectExprs))
 		aggregates := make([]string, len(statement.SelectExprs))
 		keyPart := make([]int, len(statement.SelectExprs))
 		aliases := make([]string, len(statement.SelectExprs))
@@ -106,6 +83,11 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 		for i := range statement.SelectExprs {
 			inExpr := statement.SelectExprs[i].(*sqlparser.AliasedExpr).Expr
 			aliases[i] = statement.SelectExprs[i].(*sqlparser.AliasedExpr).As.String()
+			if isGroupingFunction(inExpr) {
+				// GROUPING calls get resolved once the name of the grouping set field is known.
+				isGrouping[i] = true
+				continue
+			}
 			agg, expr, err := ParseAggregate(inExpr)
 			if err == nil {
 				isAggregate[i] = true
@@ -137,6 +119,7 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 		}
 
 		outputExprs := make([]logical.Expression, len(isAggregate))
+		outputAliases := make([]string, len(isAggregate))
 		var nonKeyAggregates []string
 		var aggregateExprs []logical.Expression
 		var aggregateFieldNames []string
@@ -154,6 +137,9 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			return name
 		}
 		for i, ok := range isAggregate {
+			if isGrouping[i] {
+				continue
+			}
 			if ok {
 				nonKeyAggregates = append(nonKeyAggregates, aggregates[i])
 				aggregateExprs = append(aggregateExprs, expressions[i])
@@ -181,9 +167,111 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			}
 		}
 
-		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFieldNames, triggers)
-		root = logical.NewMap(outputExprs, make([]string, len(outputExprs)), make([]string, len(outputExprs)), make([]bool, len(outputExprs)), make([]logical.Expression, len(outputExprs)), make([]bool, len(outputExprs)), root)
+		var groupingSetFieldName string
+		if groupingSets != nil {
+			groupingSetFieldName = getUniqueName("grouping_set")
+		}
+		for i := range isGrouping {
+			if !isGrouping[i] {
+				continue
+			}
+			groupingExpr, err := ParseGroupingFunction(statement.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.FuncExpr), key, groupingSets, groupingSetFieldName)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse grouping function with index %d", i)
+			}
+			outputExprs[i], err = ParseExpression(groupingExpr)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse grouping function with index %d", i)
+			}
+			outputAliases[i] = "grouping"
+			if aliases[i] != "" {
+				outputAliases[i] = aliases[i]
+			}
+		}
+
+		var havingPredicate logical.Expression
+		if statement.Having != nil {
+			// Aggregates and group key expressions in the HAVING clause get replaced by references to the group by output fields.
+			// Aggregates which aren't part of the select list are added as hidden fields, which get projected away by the final map.
+			type replacement struct {
+				from, to sqlparser.Expr
+			}
+			var replacements []replacement
+			if err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				expr, ok := node.(sqlparser.Expr)
+				if !ok {
+					return true, nil
+				}
+				if _, ok := expr.(*sqlparser.Subquery); ok {
+					return false, nil
+				}
+				if isGroupingFunction(expr) {
+					groupingExpr, err := ParseGroupingFunction(expr.(*sqlparser.FuncExpr), key, groupingSets, groupingSetFieldName)
+					if err != nil {
+						return false, errors.Wrap(err, "couldn't parse grouping function in having clause")
+					}
+					replacements = append(replacements, replacement{from: expr, to: groupingExpr})
+					return false, nil
+				}
+				if isAggregateExpression(expr) {
+					agg, aggExpr, err := ParseAggregate(expr)
+					if err != nil {
+						return false, errors.Wrap(err, "couldn't parse aggregate in having clause")
+					}
+					name := ""
+					for i := range nonKeyAggregates {
+						if nonKeyAggregates[i] == agg && logical.EqualExpressions(aggregateExprs[i], aggExpr) {
+							name = aggregateFieldNames[i]
+							break
+						}
+					}
+					if name == "" {
+						if namer, ok := aggExpr.(logical.FieldNamer); ok {
+							name = getUniqueName(fmt.Sprintf("%s_%s", agg, namer.FieldName()))
+						} else {
+							name = getUniqueName(agg)
+						}
+						nonKeyAggregates = append(nonKeyAggregates, agg)
+						aggregateExprs = append(aggregateExprs, aggExpr)
+						aggregateFieldNames = append(aggregateFieldNames, name)
+					}
+					replacements = append(replacements, replacement{from: expr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}})
+					return false, nil
+				}
+				if parsed, err := ParseExpression(expr); err == nil {
+					for keyIndex := range key {
+						if logical.EqualExpressions(parsed, key[keyIndex]) {
+							replacements = append(replacements, replacement{from: expr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(keyFieldNames[keyIndex])}})
+							return false, nil
+						}
+					}
+				}
+				return true, nil
+			}, statement.Having.Expr); err != nil {
+				return nil, nil, err
+			}
+
+			havingExpr := statement.Having.Expr
+			for _, r := range replacements {
+				havingExpr = sqlparser.ReplaceExpr(havingExpr, r.from, r.to)
+			}
+			havingPredicate, err = ParseExpression(havingExpr)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse having expression")
+			}
+		}
+
+		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFieldNames, groupingSets, groupingSetFieldName, triggers)
+		if havingPredicate != nil {
+			root = logical.NewFilter(havingPredicate, root)
+		}
+		root = logical.NewMap(outputExprs, outputAliases, make([]string, len(outputExprs)), make([]bool, len(outputExprs)), make([]logical.Expression, len(outputExprs)), make([]bool, len(outputExprs)), root)
 	} else {
+		root, err = ParseWindowFunctions(root, statement.SelectExprs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse window functions")
+		}
+
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		starQualifiers := make([]string, len(statement.SelectExprs))
 		isStar := make([]bool, len(statement.SelectExprs))
@@ -247,6 +335,189 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			return nil, nil, errors.Wrap(err, "couldn't parse limit")
 		}
 		outputOptions.Limit = &limitExpr
+
+		if statement.Limit.Offset != nil {
+			offsetExpr, err := ParseExpression(statement.Limit.Offset)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse offset")
+			}
+			outputOptions.Offset = &offsetExpr
+		}
+	}
+
+	return root, outputOptions, nil
+}
+
+// ParseWindowFunctions adds a window node to the root for each distinct window specification used in the select expressions.
+// Window function calls in the select expressions get replaced by references to the window node output fields.
+func ParseWindowFunctions(root logical.Node, selectExprs sqlparser.SelectExprs) (logical.Node, error) {
+	type window struct {
+		spec          *sqlparser.WindowSpec
+		functions     []string
+		arguments     [][]logical.Expression
+		functionNames []string
+	}
+	var windows []*window
+	windowBySpec := map[string]*window{}
+
+	nameCounter := map[string]int{}
+	getUniqueName := func(name string) string {
+		count, ok := nameCounter[name]
+		if ok {
+			name = fmt.Sprintf("%s_%d", name, count)
+		}
+		nameCounter[name] = count + 1
+		return name
+	}
+
+	for i := range selectExprs {
+		aliasedExpr, ok := selectExprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+
+		type replacement struct {
+			from, to sqlparser.Expr
+		}
+		var replacements []replacement
+		if err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if _, ok := node.(*sqlparser.Subquery); ok {
+				return false, nil
+			}
+			funcExpr, ok := node.(*sqlparser.FuncExpr)
+			if !ok || funcExpr.Over == nil {
+				return true, nil
+			}
+
+			functionName := strings.ToLower(funcExpr.Name.String())
+			if funcExpr.Distinct {
+				functionName = fmt.Sprintf("%v_distinct", functionName)
+			}
+			arguments := make([]logical.Expression, len(funcExpr.Exprs))
+			for j := range funcExpr.Exprs {
+				switch arg := funcExpr.Exprs[j].(type) {
+				case *sqlparser.AliasedExpr:
+					parsed, err := ParseExpression(arg.Expr)
+					if err != nil {
+						return false, errors.Wrapf(err, "couldn't parse argument with index %d of window function %s", j, functionName)
+					}
+					arguments[j] = parsed
+				case *sqlparser.StarExpr:
+					arguments[j] = logical.NewConstant(octosql.NewBoolean(true))
+				default:
+					return false, errors.Errorf("invalid window function argument expression type: %v", reflect.TypeOf(funcExpr.Exprs[j]))
+				}
+			}
+
+			specString := sqlparser.String(funcExpr.Over)
+			w, ok := windowBySpec[specString]
+			if !ok {
+				w = &window{spec: funcExpr.Over}
+				windows = append(windows, w)
+				windowBySpec[specString] = w
+			}
+
+			var name string
+			if aliasedExpr.Expr == funcExpr && !aliasedExpr.As.IsEmpty() {
+				name = getUniqueName(aliasedExpr.As.String())
+			} else {
+				name = getUniqueName(functionName)
+			}
+			w.functions = append(w.functions, functionName)
+			w.arguments = append(w.arguments, arguments)
+			w.functionNames = append(w.functionNames, name)
+			replacements = append(replacements, replacement{from: funcExpr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}})
+
+			return false, nil
+		}, aliasedExpr.Expr); err != nil {
+			return nil, err
+		}
+
+		for _, r := range replacements {
+			aliasedExpr.Expr = sqlparser.ReplaceExpr(aliasedExpr.Expr, r.from, r.to)
+		}
+	}
+
+	for _, w := range windows {
+		partitionBy := make([]logical.Expression, len(w.spec.PartitionBy))
+		for i := range w.spec.PartitionBy {
+			expr, err := ParseExpression(w.spec.PartitionBy[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse partition by expression with index %d", i)
+			}
+			partitionBy[i] = expr
+		}
+		orderByExpressions, orderByDirections, err := parseOrderByExpressions(w.spec.OrderBy)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse window order by")
+		}
+
+		root = logical.NewWindow(root, partitionBy, orderByExpressions, orderByDirections, w.functions, w.arguments, w.functionNames)
+	}
+
+	return root, nil
+}
+
+func ParseUnion(statement *sqlparser.Union) (logical.Node, *OutputOptions, error) {
+	var root logical.Node
+	outputOptions := &OutputOptions{}
+
+	firstNode, err := ParseNestedNode(statement.Left)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse first select expression")
+	}
+
+	secondNode, err := ParseNestedNode(statement.Right)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse second select expression")
+	}
+	switch statement.Type {
+	case sqlparser.UnionAllStr:
+		root = logical.NewUnionAll(firstNode, secondNode)
+
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		root = logical.NewUnionDistinct(firstNode, secondNode)
+
+	case sqlparser.IntersectAllStr:
+		root = logical.NewIntersect(firstNode, secondNode, false)
+
+	case sqlparser.IntersectDistinctStr, sqlparser.IntersectStr:
+		root = logical.NewIntersect(firstNode, secondNode, true)
+
+	case sqlparser.ExceptAllStr:
+		root = logical.NewExcept(firstNode, secondNode, false)
+
+	case sqlparser.ExceptDistinctStr, sqlparser.ExceptStr:
+		root = logical.NewExcept(firstNode, secondNode, true)
+
+	default:
+		return nil, nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
+	}
+
+	if statement.OrderBy != nil {
+		orderByExpressions, orderByDirections, err := parseOrderByExpressions(statement.OrderBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
+		}
+
+		outputOptions.OrderByExpressions = orderByExpressions
+		outputOptions.OrderByDirections = orderByDirections
+	}
+
+	if statement.Limit != nil {
+		limitExpr, err := ParseExpression(statement.Limit.Rowcount)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse limit")
+		}
+		outputOptions.Limit = &limitExpr
+
+		if statement.Limit.Offset != nil {
+			offsetExpr, err := ParseExpression(statement.Limit.Offset)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse offset")
+			}
+			outputOptions.Offset = &offsetExpr
+		}
 	}
 
 	return root, outputOptions, nil
@@ -260,16 +531,70 @@ func ParseWith(statement *sqlparser.With) (logical.Node, *OutputOptions, error)
 
 	nodes := make([]logical.Node, len(statement.CommonTableExpressions))
 	names := make([]string, len(statement.CommonTableExpressions))
+	columns := make([][]string, len(statement.CommonTableExpressions))
 	for i, cte := range statement.CommonTableExpressions {
-		node, err := ParseNestedNode(cte.Select)
+		names[i] = cte.Name.String()
+		for _, column := range cte.Columns {
+			columns[i] = append(columns[i], column.String())
+		}
+
+		var node logical.Node
+		var err error
+		if statement.Recursive && referencesTable(cte.Select, names[i]) {
+			node, err = ParseRecursiveCommonTableExpression(names[i], columns[i], cte.Select)
+		} else {
+			node, err = ParseNestedNode(cte.Select)
+		}
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "couldn't parse common table expression %s with index %d", cte.Name, i)
 		}
 		nodes[i] = node
-		names[i] = cte.Name.String()
 	}
 
-	return logical.NewWith(names, nodes, source), outputOptions, nil
+	return logical.NewWith(names, columns, nodes, source), outputOptions, nil
+}
+
+func ParseRecursiveCommonTableExpression(name string, columns []string, statement sqlparser.SelectStatement) (logical.Node, error) {
+	union, ok := statement.(*sqlparser.Union)
+	if !ok || union.OrderBy != nil || union.Limit != nil {
+		return nil, errors.Errorf("recursive common table expression must be of the form <anchor part> UNION [ALL] <recursive part>")
+	}
+	if referencesTable(union.Left, name) {
+		return nil, errors.Errorf("anchor part of recursive common table expression mustn't reference %s", name)
+	}
+
+	var distinct bool
+	switch union.Type {
+	case sqlparser.UnionAllStr:
+		distinct = false
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		distinct = true
+	default:
+		return nil, errors.Errorf("unsupported union %+v of type %v", union, union.Type)
+	}
+
+	anchor, err := ParseNestedNode(union.Left)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse anchor part")
+	}
+	recursive, err := ParseNestedNode(union.Right)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse recursive part")
+	}
+
+	return logical.NewRecursiveCTE(name, columns, anchor, recursive, distinct), nil
+}
+
+// referencesTable checks if the statement reads from a table with the given unqualified name.
+func referencesTable(statement sqlparser.SelectStatement, name string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name {
+			found = true
+		}
+		return !found, nil
+	}, statement)
+	return found
 }
 
 func ParseNode(statement sqlparser.SelectStatement) (logical.Node, *OutputOptions, error) {
@@ -277,9 +602,8 @@ func ParseNode(statement sqlparser.SelectStatement) (logical.Node, *OutputOption
 	case *sqlparser.Select:
 		return ParseSelect(statement)
 
-	// case *sqlparser.Union:
-	// 	plan, err := ParseUnion(statement)
-	// 	return plan, &logical.OutputOptions{}, err
+	case *sqlparser.Union:
+		return ParseUnion(statement)
 
 	case *sqlparser.ParenSelect:
 		return ParseNode(statement.Select)
@@ -298,7 +622,7 @@ func ParseNestedNode(statement sqlparser.SelectStatement) (logical.Node, error)
 		return nil, err
 	}
 	if len(outputOptions.OrderByExpressions) > 0 || outputOptions.Limit != nil {
-		node = logical.NewOrderSensitiveTransform(outputOptions.OrderByExpressions, outputOptions.OrderByDirections, outputOptions.Limit, node)
+		node = logical.NewOrderSensitiveTransform(outputOptions.OrderByExpressions, outputOptions.OrderByDirections, outputOptions.Limit, outputOptions.Offset, node)
 	}
 	return node, nil
 }
@@ -461,11 +785,197 @@ func ParseTableValuedFunctionArgument(expr sqlparser.TableValuedFunctionArgument
 	}
 }
 
+// maxCubeExpressions bounds the number of expressions in a CUBE, which results in 2^n grouping sets.
+const maxCubeExpressions = 12
+
+// ParseGroupBy returns the group by key expressions.
+// If the GROUP BY clause contains any ROLLUP, CUBE or GROUPING SETS elements,
+// it also returns the grouping sets, as lists of key indices.
+// Those are the cross product of the grouping sets of all the elements.
+func ParseGroupBy(groupBy sqlparser.GroupBy) ([]logical.Expression, [][]int, error) {
+	hasGroupingSets := false
+	for i := range groupBy {
+		switch groupBy[i].(type) {
+		case *sqlparser.RollupExpr, *sqlparser.CubeExpr, *sqlparser.GroupingSetsExpr:
+			hasGroupingSets = true
+		}
+	}
+	if !hasGroupingSets {
+		key := make([]logical.Expression, len(groupBy))
+		for i := range groupBy {
+			var err error
+			key[i], err = ParseExpression(groupBy[i])
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %v", i)
+			}
+		}
+		return key, nil, nil
+	}
+
+	// With grouping sets, each unique expression is part of the key once.
+	var key []logical.Expression
+	getKeyIndices := func(exprs sqlparser.Exprs) ([]int, error) {
+		indices := make([]int, len(exprs))
+	exprLoop:
+		for i := range exprs {
+			expr, err := ParseExpression(exprs[i])
+			if err != nil {
+				return nil, err
+			}
+			for keyIndex := range key {
+				if logical.EqualExpressions(expr, key[keyIndex]) {
+					indices[i] = keyIndex
+					continue exprLoop
+				}
+			}
+			key = append(key, expr)
+			indices[i] = len(key) - 1
+		}
+		return indices, nil
+	}
+
+	groupingSets := [][]int{{}}
+	for i := range groupBy {
+		var elementSets [][]int
+		switch expr := groupBy[i].(type) {
+		case *sqlparser.RollupExpr:
+			indices, err := getKeyIndices(expr.Exprs)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse rollup expression with index %v", i)
+			}
+			for n := len(indices); n >= 0; n-- {
+				elementSets = append(elementSets, indices[:n])
+			}
+		case *sqlparser.CubeExpr:
+			if len(expr.Exprs) > maxCubeExpressions {
+				return nil, nil, errors.Errorf("cube can contain at most %d expressions, got %d", maxCubeExpressions, len(expr.Exprs))
+			}
+			indices, err := getKeyIndices(expr.Exprs)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse cube expression with index %v", i)
+			}
+			// Subsets are ordered from the full set down to the empty one, the first expression being the most significant.
+			for mask := 1<<len(indices) - 1; mask >= 0; mask-- {
+				set := []int{}
+				for j := range indices {
+					if mask&(1<<(len(indices)-1-j)) != 0 {
+						set = append(set, indices[j])
+					}
+				}
+				elementSets = append(elementSets, set)
+			}
+		case *sqlparser.GroupingSetsExpr:
+			for j := range expr.Sets {
+				indices, err := getKeyIndices(expr.Sets[j])
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "couldn't parse grouping set with index %v of grouping sets expression with index %v", j, i)
+				}
+				elementSets = append(elementSets, indices)
+			}
+		default:
+			indices, err := getKeyIndices(sqlparser.Exprs{expr})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %v", i)
+			}
+			elementSets = [][]int{indices}
+		}
+
+		newGroupingSets := make([][]int, 0, len(groupingSets)*len(elementSets))
+		for _, set := range groupingSets {
+			for _, elementSet := range elementSets {
+				newSet := make([]int, 0, len(set)+len(elementSet))
+				newSet = append(newSet, set...)
+				newSet = append(newSet, elementSet...)
+				newGroupingSets = append(newGroupingSets, newSet)
+			}
+		}
+		groupingSets = newGroupingSets
+	}
+
+	return key, groupingSets, nil
+}
+
+func isGroupingFunction(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && funcExpr.Over == nil && strings.ToLower(funcExpr.Name.String()) == "grouping"
+}
+
+// ParseGroupingFunction rewrites a GROUPING call into a CASE expression over the grouping set field.
+// The result has a bit set for each argument which isn't part of the grouping set, the last argument being the least significant.
+func ParseGroupingFunction(expr *sqlparser.FuncExpr, key []logical.Expression, groupingSets [][]int, groupingSetFieldName string) (sqlparser.Expr, error) {
+	argKeyIndices := make([]int, len(expr.Exprs))
+argLoop:
+	for i := range expr.Exprs {
+		aliasedExpr, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("invalid grouping argument with index %d: %s", i, sqlparser.String(expr.Exprs[i]))
+		}
+		arg, err := ParseExpression(aliasedExpr.Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse grouping argument with index %d", i)
+		}
+		for keyIndex := range key {
+			if logical.EqualExpressions(arg, key[keyIndex]) {
+				argKeyIndices[i] = keyIndex
+				continue argLoop
+			}
+		}
+		return nil, errors.Errorf("grouping argument with index %d must be part of group by key", i)
+	}
+
+	if groupingSets == nil {
+		// There's just one grouping set, containing all key expressions.
+		groupingSets = [][]int{argKeyIndices}
+	}
+
+	values := make([]int, len(groupingSets))
+	for setIndex, keyIndices := range groupingSets {
+		for _, argKeyIndex := range argKeyIndices {
+			values[setIndex] <<= 1
+			isPresent := false
+			for _, keyIndex := range keyIndices {
+				if keyIndex == argKeyIndex {
+					isPresent = true
+				}
+			}
+			if !isPresent {
+				values[setIndex] |= 1
+			}
+		}
+	}
+
+	allEqual := true
+	for i := range values {
+		if values[i] != values[0] {
+			allEqual = false
+		}
+	}
+	if allEqual {
+		return sqlparser.NewIntVal([]byte(strconv.Itoa(values[0]))), nil
+	}
+
+	whens := make([]*sqlparser.When, len(groupingSets)-1)
+	for setIndex := range whens {
+		whens[setIndex] = &sqlparser.When{
+			Cond: sqlparser.NewIntVal([]byte(strconv.Itoa(setIndex))),
+			Val:  sqlparser.NewIntVal([]byte(strconv.Itoa(values[setIndex]))),
+		}
+	}
+	return &sqlparser.CaseExpr{
+		Expr:  &sqlparser.ColName{Name: sqlparser.NewColIdent(groupingSetFieldName)},
+		Whens: whens,
+		Else:  sqlparser.NewIntVal([]byte(strconv.Itoa(values[len(values)-1]))),
+	}, nil
+}
+
 var ErrNotAggregate = errors.New("expression is not aggregate")
 
 func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return "", nil, errors.Wrapf(ErrNotAggregate, "window function isn't an aggregate: %v", expr.Name)
+		}
 		curAggregate := strings.ToLower(expr.Name.String())
 		if expr.Distinct {
 			curAggregate = fmt.Sprintf("%v_distinct", curAggregate)
@@ -575,6 +1085,9 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		return logical.NewFunctionExpression(expr.Operator, []logical.Expression{left, right}), nil
 
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return nil, errors.Errorf("window functions are only allowed in the select list of non-grouped queries: %v", sqlparser.String(expr))
+		}
 		functionName := strings.ToLower(expr.Name.String())
 
 		arguments := make([]logical.Expression, 0)
@@ -705,6 +1218,9 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 	case *sqlparser.OrExpr:
 		return ParseInfixOperator(expr.Left, expr.Right, "OR")
 	case *sqlparser.NotExpr:
+		if exists, ok := expr.Expr.(*sqlparser.ExistsExpr); ok {
+			return ParseExistsExpression(exists, true)
+		}
 		childParsed, err := ParseExpression(expr.Expr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "couldn't parse child of not operator %+v", expr.Expr)
@@ -712,6 +1228,10 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		return logical.NewFunctionExpression("not", []logical.Expression{childParsed}), nil
 	case *sqlparser.ComparisonExpr:
 		return ParseInfixComparison(expr.Left, expr.Right, expr.Operator)
+	case *sqlparser.RangeCond:
+		return ParseRangeCondition(expr)
+	case *sqlparser.ExistsExpr:
+		return ParseExistsExpression(expr, false)
 	case *sqlparser.ParenExpr:
 		return ParseExpression(expr.Expr)
 	case *sqlparser.IsExpr:
@@ -742,6 +1262,8 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 
 		return logical.NewTypeCast(arg, targetType), nil
+	case *sqlparser.CaseExpr:
+		return ParseCaseExpression(expr)
 	case *sqlparser.ObjectFieldAccess:
 		arg, err := ParseExpression(expr.Object)
 		if err != nil {
@@ -758,6 +1280,47 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 	}
 }
 
+func ParseCaseExpression(expr *sqlparser.CaseExpr) (logical.Expression, error) {
+	var operand logical.Expression
+	if expr.Expr != nil {
+		var err error
+		operand, err = ParseExpression(expr.Expr)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse CASE operand")
+		}
+	}
+
+	conditions := make([]logical.Expression, len(expr.Whens))
+	results := make([]logical.Expression, len(expr.Whens))
+	for i, when := range expr.Whens {
+		condition, err := ParseExpression(when.Cond)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse CASE condition with index %d", i)
+		}
+		if operand != nil {
+			// CASE x WHEN a THEN ... is equivalent to CASE WHEN x = a THEN ...
+			condition = logical.NewFunctionExpression("=", []logical.Expression{operand, condition})
+		}
+		conditions[i] = condition
+
+		results[i], err = ParseExpression(when.Val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse CASE result with index %d", i)
+		}
+	}
+
+	var elseResult logical.Expression = logical.NewConstant(octosql.NewNull())
+	if expr.Else != nil {
+		var err error
+		elseResult, err = ParseExpression(expr.Else)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse CASE else result")
+		}
+	}
+
+	return logical.NewCase(conditions, results, elseResult), nil
+}
+
 func ParseType(t sqlparser.ConvertType) (octosql.TypeID, error) {
 	switch t := t.(type) {
 	case *sqlparser.ConvertTypeList:
@@ -791,6 +1354,10 @@ func ParseType(t sqlparser.ConvertType) (octosql.TypeID, error) {
 func isAggregateExpression(expr sqlparser.Expr) bool {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			// This is a window function.
+			return false
+		}
 		functionName := strings.ToLower(expr.Name.String())
 
 		if _, ok := aggregates.Aggregates[functionName]; ok {
@@ -829,6 +1396,36 @@ func ParsePrefixOperator(child sqlparser.Expr, operator string) (logical.Express
 	return logical.NewFunctionExpression(operator, []logical.Expression{childParsed}), nil
 }
 
+func ParseRangeCondition(expr *sqlparser.RangeCond) (logical.Expression, error) {
+	leftParsed, err := ParseExpression(expr.Left)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse left hand side of %s operator %+v", expr.Operator, expr.Left)
+	}
+	fromParsed, err := ParseExpression(expr.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse lower bound of %s operator %+v", expr.Operator, expr.From)
+	}
+	toParsed, err := ParseExpression(expr.To)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse upper bound of %s operator %+v", expr.Operator, expr.To)
+	}
+
+	switch expr.Operator {
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		return logical.NewFunctionExpression(expr.Operator, []logical.Expression{leftParsed, fromParsed, toParsed}), nil
+	default:
+		return nil, errors.Errorf("unsupported range operator: %s", expr.Operator)
+	}
+}
+
+func ParseExistsExpression(expr *sqlparser.ExistsExpr, not bool) (logical.Expression, error) {
+	subquery, err := ParseNestedNode(expr.Subquery.Select)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse exists subquery")
+	}
+	return logical.NewExistsQueryExpression(subquery, not), nil
+}
+
 func ParseInfixComparison(left, right sqlparser.Expr, operator string) (logical.Expression, error) {
 	leftParsed, err := ParseExpression(left)
 	if err != nil {
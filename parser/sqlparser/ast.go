@@ -440,7 +440,7 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 	)
 }
 
-// Union represents a UNION statement.
+// Union represents a UNION, INTERSECT or EXCEPT statement.
 type Union struct {
 	Type        string
 	Left, Right SelectStatement
@@ -451,9 +451,15 @@ type Union struct {
 
 // Union.Type
 const (
-	UnionStr         = "union"
-	UnionAllStr      = "union all"
-	UnionDistinctStr = "union distinct"
+	UnionStr             = "union"
+	UnionAllStr          = "union all"
+	UnionDistinctStr     = "union distinct"
+	IntersectStr         = "intersect"
+	IntersectAllStr      = "intersect all"
+	IntersectDistinctStr = "intersect distinct"
+	ExceptStr            = "except"
+	ExceptAllStr         = "except all"
+	ExceptDistinctStr    = "except distinct"
 )
 
 // Format formats the node.
@@ -474,11 +480,16 @@ func (node *Union) walkSubtree(visit Visit) error {
 }
 
 type With struct {
+	Recursive              bool
 	CommonTableExpressions CommonTableExpressions
 	Select                 SelectStatement
 }
 
 func (node *With) Format(buf *TrackedBuffer) {
+	if node.Recursive {
+		buf.Myprintf("WITH RECURSIVE %v %v", node.CommonTableExpressions, node.Select)
+		return
+	}
 	buf.Myprintf("WITH %v %v", node.CommonTableExpressions, node.Select)
 }
 
@@ -512,18 +523,22 @@ func (node CommonTableExpressions) walkSubtree(visit Visit) error {
 }
 
 type CommonTableExpression struct {
-	Name   TableIdent
-	Select SelectStatement
+	Name    TableIdent
+	Columns Columns
+	Select  SelectStatement
 }
 
 func (node *CommonTableExpression) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%s AS (%v)", node.Name, node.Select)
+	buf.Myprintf("%s%v AS (%v)", node.Name, node.Columns, node.Select)
 }
 
 func (node *CommonTableExpression) walkSubtree(visit Visit) error {
 	if err := Walk(visit, node.Name); err != nil {
 		return err
 	}
+	if err := Walk(visit, node.Columns); err != nil {
+		return err
+	}
 	if err := Walk(visit, node.Select); err != nil {
 		return err
 	}
@@ -2386,6 +2401,9 @@ func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
 func (*Default) iExpr()           {}
 func (*ObjectFieldAccess) iExpr() {}
+func (*RollupExpr) iExpr()        {}
+func (*CubeExpr) iExpr()          {}
+func (*GroupingSetsExpr) iExpr()  {}
 
 // ReplaceExpr finds the from expression from root
 // and replaces it with to. If from matches root,
@@ -3202,6 +3220,7 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	Over      *WindowSpec
 }
 
 // Format formats the node.
@@ -3217,6 +3236,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// if they match a reserved word. So, print the
 	// name as is.
 	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	if node.Over != nil {
+		buf.Myprintf(" over (%v)", node.Over)
+	}
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3228,6 +3250,7 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -3241,9 +3264,51 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 			return true
 		}
 	}
+	if node.Over != nil {
+		for i := range node.Over.PartitionBy {
+			if replaceExprs(from, to, &node.Over.PartitionBy[i]) {
+				return true
+			}
+		}
+		for _, order := range node.Over.OrderBy {
+			if replaceExprs(from, to, &order.Expr) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
+// WindowSpec represents the window specification of a window function call.
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	prefix := "order by "
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+		prefix = " order by "
+	}
+	for _, n := range node.OrderBy {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node *WindowSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
+	)
+}
+
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
 	"avg":          true,
@@ -3311,6 +3376,97 @@ func (node *GroupConcatExpr) replace(from, to Expr) bool {
 	return false
 }
 
+// RollupExpr represents a ROLLUP grouping element of a GROUP BY clause.
+type RollupExpr struct {
+	Exprs Exprs
+}
+
+// Format formats the node.
+func (node *RollupExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollup(%v)", node.Exprs)
+}
+
+func (node *RollupExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Exprs)
+}
+
+func (node *RollupExpr) replace(from, to Expr) bool {
+	for i := range node.Exprs {
+		if replaceExprs(from, to, &node.Exprs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// CubeExpr represents a CUBE grouping element of a GROUP BY clause.
+type CubeExpr struct {
+	Exprs Exprs
+}
+
+// Format formats the node.
+func (node *CubeExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("cube(%v)", node.Exprs)
+}
+
+func (node *CubeExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Exprs)
+}
+
+func (node *CubeExpr) replace(from, to Expr) bool {
+	for i := range node.Exprs {
+		if replaceExprs(from, to, &node.Exprs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupingSetsExpr represents a GROUPING SETS grouping element of a GROUP BY clause.
+type GroupingSetsExpr struct {
+	Sets []Exprs
+}
+
+// Format formats the node.
+func (node *GroupingSetsExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("grouping sets(")
+	prefix := ""
+	for _, set := range node.Sets {
+		buf.Myprintf("%s(%v)", prefix, set)
+		prefix = ", "
+	}
+	buf.Myprintf(")")
+}
+
+func (node *GroupingSetsExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, set := range node.Sets {
+		if err := Walk(visit, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (node *GroupingSetsExpr) replace(from, to Expr) bool {
+	for _, set := range node.Sets {
+		for i := range set {
+			if replaceExprs(from, to, &set[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ValuesFuncExpr represents a function call.
 type ValuesFuncExpr struct {
 	Name *ColName
@@ -3786,7 +3942,7 @@ type DelayTrigger struct {
 }
 
 func (w *DelayTrigger) Format(buf *TrackedBuffer) {
-	buf.Myprintf("DELAY %v", w.Delay)
+	buf.Myprintf("AFTER DELAY %v", w.Delay)
 }
 
 func (w *DelayTrigger) walkSubtree(visit Visit) error {
@@ -70,6 +70,7 @@ type yySymType struct {
 	indexHints                       *IndexHints
 	expr                             Expr
 	exprs                            Exprs
+	exprsList                        []Exprs
 	boolVal                          BoolVal
 	sqlVal                           *SQLVal
 	colTuple                         ColTuple
@@ -79,6 +80,7 @@ type yySymType struct {
 	whens                            []*When
 	when                             *When
 	orderBy                          OrderBy
+	windowSpec                       *WindowSpec
 	order                            *Order
 	limit                            *Limit
 	triggers                         []Trigger
@@ -117,266 +119,274 @@ type yySymType struct {
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const WATERMARK = 57362
-const DELAY = 57363
-const COUNTING = 57364
-const AFTER = 57365
-const ALL = 57366
-const DISTINCT = 57367
-const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const KEY = 57374
-const DEFAULT = 57375
-const SET = 57376
-const LOCK = 57377
-const UNLOCK = 57378
-const KEYS = 57379
-const VALUES = 57380
-const LAST_INSERT_ID = 57381
-const NEXT = 57382
-const VALUE = 57383
-const SHARE = 57384
-const MODE = 57385
-const SQL_NO_CACHE = 57386
-const SQL_CACHE = 57387
-const JOIN = 57388
-const STRAIGHT_JOIN = 57389
-const LOOKUP = 57390
-const LEFT = 57391
-const RIGHT = 57392
-const INNER = 57393
-const OUTER = 57394
-const CROSS = 57395
-const NATURAL = 57396
-const USE = 57397
-const FORCE = 57398
-const ON = 57399
-const USING = 57400
-const ID = 57401
-const HEX = 57402
-const STRING = 57403
-const INTEGRAL = 57404
-const FLOAT = 57405
-const HEXNUM = 57406
-const VALUE_ARG = 57407
-const LIST_ARG = 57408
-const COMMENT = 57409
-const COMMENT_KEYWORD = 57410
-const BIT_LITERAL = 57411
-const LIST_TYPE = 57412
-const OBJECT_TYPE = 57413
-const NULL = 57414
-const TRUE = 57415
-const FALSE = 57416
-const OFF = 57417
-const OR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const OF = 57427
-const LE = 57428
-const GE = 57429
-const NE = 57430
-const NULL_SAFE_EQUAL = 57431
-const IS = 57432
-const LIKE = 57433
-const REGEXP = 57434
-const IN = 57435
-const RIGHTARROW = 57436
-const SHIFT_LEFT = 57437
-const SHIFT_RIGHT = 57438
-const DIV = 57439
-const MOD = 57440
-const NOT_LIKE_REGEXP = 57441
-const LIKE_REGEXP_CASE_INSENSITIVE = 57442
-const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57443
-const UNARY = 57444
-const COLLATE = 57445
-const BINARY = 57446
-const UNDERSCORE_BINARY = 57447
-const UNDERSCORE_UTF8MB4 = 57448
-const INTERVAL = 57449
-const JSON_EXPLODE_OP = 57450
-const JSON_EXTRACT_OP = 57451
-const JSON_UNQUOTE_EXTRACT_OP = 57452
-const CREATE = 57453
-const ALTER = 57454
-const DROP = 57455
-const RENAME = 57456
-const ANALYZE = 57457
-const ADD = 57458
-const FLUSH = 57459
-const SCHEMA = 57460
-const TABLE = 57461
-const DESCRIPTOR = 57462
-const INDEX = 57463
-const VIEW = 57464
-const TO = 57465
-const IGNORE = 57466
-const IF = 57467
-const UNIQUE = 57468
-const PRIMARY = 57469
-const COLUMN = 57470
-const SPATIAL = 57471
-const FULLTEXT = 57472
-const KEY_BLOCK_SIZE = 57473
-const ACTION = 57474
-const CASCADE = 57475
-const CONSTRAINT = 57476
-const FOREIGN = 57477
-const NO = 57478
-const REFERENCES = 57479
-const RESTRICT = 57480
-const SHOW = 57481
-const DESCRIBE = 57482
-const EXPLAIN = 57483
-const DATE = 57484
-const ESCAPE = 57485
-const REPAIR = 57486
-const OPTIMIZE = 57487
-const TRUNCATE = 57488
-const MAXVALUE = 57489
-const PARTITION = 57490
-const REORGANIZE = 57491
-const LESS = 57492
-const THAN = 57493
-const PROCEDURE = 57494
-const TRIGGER = 57495
-const VINDEX = 57496
-const VINDEXES = 57497
-const STATUS = 57498
-const VARIABLES = 57499
-const WARNINGS = 57500
-const BEGIN = 57501
-const START = 57502
-const TRANSACTION = 57503
-const COMMIT = 57504
-const ROLLBACK = 57505
-const BIT = 57506
-const TINYINT = 57507
-const SMALLINT = 57508
-const MEDIUMINT = 57509
-const INT = 57510
-const INTEGER = 57511
-const BIGINT = 57512
-const INTNUM = 57513
-const REAL = 57514
-const DOUBLE = 57515
-const FLOAT_TYPE = 57516
-const DECIMAL = 57517
-const NUMERIC = 57518
-const TIME = 57519
-const TIMESTAMP = 57520
-const DATETIME = 57521
-const YEAR = 57522
-const CHAR = 57523
-const VARCHAR = 57524
-const BOOL = 57525
-const CHARACTER = 57526
-const VARBINARY = 57527
-const NCHAR = 57528
-const TEXT = 57529
-const TINYTEXT = 57530
-const MEDIUMTEXT = 57531
-const LONGTEXT = 57532
-const BLOB = 57533
-const TINYBLOB = 57534
-const MEDIUMBLOB = 57535
-const LONGBLOB = 57536
-const JSON = 57537
-const ENUM = 57538
-const GEOMETRY = 57539
-const POINT = 57540
-const LINESTRING = 57541
-const POLYGON = 57542
-const GEOMETRYCOLLECTION = 57543
-const MULTIPOINT = 57544
-const MULTILINESTRING = 57545
-const MULTIPOLYGON = 57546
-const NULLX = 57547
-const AUTO_INCREMENT = 57548
-const APPROXNUM = 57549
-const SIGNED = 57550
-const UNSIGNED = 57551
-const ZEROFILL = 57552
-const COLLATION = 57553
-const DATABASES = 57554
-const SCHEMAS = 57555
-const TABLES = 57556
-const VITESS_KEYSPACES = 57557
-const VITESS_SHARDS = 57558
-const VITESS_TABLETS = 57559
-const VSCHEMA = 57560
-const VSCHEMA_TABLES = 57561
-const VITESS_TARGET = 57562
-const FULL = 57563
-const PROCESSLIST = 57564
-const COLUMNS = 57565
-const FIELDS = 57566
-const ENGINES = 57567
-const PLUGINS = 57568
-const NAMES = 57569
-const CHARSET = 57570
-const GLOBAL = 57571
-const SESSION = 57572
-const ISOLATION = 57573
-const LEVEL = 57574
-const READ = 57575
-const WRITE = 57576
-const ONLY = 57577
-const REPEATABLE = 57578
-const COMMITTED = 57579
-const UNCOMMITTED = 57580
-const SERIALIZABLE = 57581
-const CURRENT_TIMESTAMP = 57582
-const DATABASE = 57583
-const CURRENT_DATE = 57584
-const CURRENT_TIME = 57585
-const LOCALTIME = 57586
-const LOCALTIMESTAMP = 57587
-const UTC_DATE = 57588
-const UTC_TIME = 57589
-const UTC_TIMESTAMP = 57590
-const REPLACE = 57591
-const CONVERT = 57592
-const CAST = 57593
-const SUBSTR = 57594
-const SUBSTRING = 57595
-const GROUP_CONCAT = 57596
-const SEPARATOR = 57597
-const TIMESTAMPADD = 57598
-const TIMESTAMPDIFF = 57599
-const MATCH = 57600
-const AGAINST = 57601
-const BOOLEAN = 57602
-const LANGUAGE = 57603
-const WITH = 57604
-const QUERY = 57605
-const EXPANSION = 57606
-const UNUSED = 57607
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const WATERMARK = 57364
+const DELAY = 57365
+const COUNTING = 57366
+const AFTER = 57367
+const OVER = 57368
+const RECURSIVE = 57369
+const ROLLUP = 57370
+const CUBE = 57371
+const GROUPING = 57372
+const SETS = 57373
+const ALL = 57374
+const DISTINCT = 57375
+const AS = 57376
+const EXISTS = 57377
+const ASC = 57378
+const DESC = 57379
+const INTO = 57380
+const DUPLICATE = 57381
+const KEY = 57382
+const DEFAULT = 57383
+const SET = 57384
+const LOCK = 57385
+const UNLOCK = 57386
+const KEYS = 57387
+const VALUES = 57388
+const LAST_INSERT_ID = 57389
+const NEXT = 57390
+const VALUE = 57391
+const SHARE = 57392
+const MODE = 57393
+const SQL_NO_CACHE = 57394
+const SQL_CACHE = 57395
+const JOIN = 57396
+const STRAIGHT_JOIN = 57397
+const LOOKUP = 57398
+const LEFT = 57399
+const RIGHT = 57400
+const INNER = 57401
+const OUTER = 57402
+const CROSS = 57403
+const NATURAL = 57404
+const USE = 57405
+const FORCE = 57406
+const ON = 57407
+const USING = 57408
+const ID = 57409
+const HEX = 57410
+const STRING = 57411
+const INTEGRAL = 57412
+const FLOAT = 57413
+const HEXNUM = 57414
+const VALUE_ARG = 57415
+const LIST_ARG = 57416
+const COMMENT = 57417
+const COMMENT_KEYWORD = 57418
+const BIT_LITERAL = 57419
+const LIST_TYPE = 57420
+const OBJECT_TYPE = 57421
+const NULL = 57422
+const TRUE = 57423
+const FALSE = 57424
+const OFF = 57425
+const OR = 57426
+const AND = 57427
+const NOT = 57428
+const BETWEEN = 57429
+const CASE = 57430
+const WHEN = 57431
+const THEN = 57432
+const ELSE = 57433
+const END = 57434
+const OF = 57435
+const LE = 57436
+const GE = 57437
+const NE = 57438
+const NULL_SAFE_EQUAL = 57439
+const IS = 57440
+const LIKE = 57441
+const REGEXP = 57442
+const IN = 57443
+const RIGHTARROW = 57444
+const SHIFT_LEFT = 57445
+const SHIFT_RIGHT = 57446
+const DIV = 57447
+const MOD = 57448
+const NOT_LIKE_REGEXP = 57449
+const LIKE_REGEXP_CASE_INSENSITIVE = 57450
+const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57451
+const UNARY = 57452
+const COLLATE = 57453
+const BINARY = 57454
+const UNDERSCORE_BINARY = 57455
+const UNDERSCORE_UTF8MB4 = 57456
+const INTERVAL = 57457
+const JSON_EXPLODE_OP = 57458
+const JSON_EXTRACT_OP = 57459
+const JSON_UNQUOTE_EXTRACT_OP = 57460
+const CREATE = 57461
+const ALTER = 57462
+const DROP = 57463
+const RENAME = 57464
+const ANALYZE = 57465
+const ADD = 57466
+const FLUSH = 57467
+const SCHEMA = 57468
+const TABLE = 57469
+const DESCRIPTOR = 57470
+const INDEX = 57471
+const VIEW = 57472
+const TO = 57473
+const IGNORE = 57474
+const IF = 57475
+const UNIQUE = 57476
+const PRIMARY = 57477
+const COLUMN = 57478
+const SPATIAL = 57479
+const FULLTEXT = 57480
+const KEY_BLOCK_SIZE = 57481
+const ACTION = 57482
+const CASCADE = 57483
+const CONSTRAINT = 57484
+const FOREIGN = 57485
+const NO = 57486
+const REFERENCES = 57487
+const RESTRICT = 57488
+const SHOW = 57489
+const DESCRIBE = 57490
+const EXPLAIN = 57491
+const DATE = 57492
+const ESCAPE = 57493
+const REPAIR = 57494
+const OPTIMIZE = 57495
+const TRUNCATE = 57496
+const MAXVALUE = 57497
+const PARTITION = 57498
+const REORGANIZE = 57499
+const LESS = 57500
+const THAN = 57501
+const PROCEDURE = 57502
+const TRIGGER = 57503
+const VINDEX = 57504
+const VINDEXES = 57505
+const STATUS = 57506
+const VARIABLES = 57507
+const WARNINGS = 57508
+const BEGIN = 57509
+const START = 57510
+const TRANSACTION = 57511
+const COMMIT = 57512
+const ROLLBACK = 57513
+const BIT = 57514
+const TINYINT = 57515
+const SMALLINT = 57516
+const MEDIUMINT = 57517
+const INT = 57518
+const INTEGER = 57519
+const BIGINT = 57520
+const INTNUM = 57521
+const REAL = 57522
+const DOUBLE = 57523
+const FLOAT_TYPE = 57524
+const DECIMAL = 57525
+const NUMERIC = 57526
+const TIME = 57527
+const TIMESTAMP = 57528
+const DATETIME = 57529
+const YEAR = 57530
+const CHAR = 57531
+const VARCHAR = 57532
+const BOOL = 57533
+const CHARACTER = 57534
+const VARBINARY = 57535
+const NCHAR = 57536
+const TEXT = 57537
+const TINYTEXT = 57538
+const MEDIUMTEXT = 57539
+const LONGTEXT = 57540
+const BLOB = 57541
+const TINYBLOB = 57542
+const MEDIUMBLOB = 57543
+const LONGBLOB = 57544
+const JSON = 57545
+const ENUM = 57546
+const GEOMETRY = 57547
+const POINT = 57548
+const LINESTRING = 57549
+const POLYGON = 57550
+const GEOMETRYCOLLECTION = 57551
+const MULTIPOINT = 57552
+const MULTILINESTRING = 57553
+const MULTIPOLYGON = 57554
+const NULLX = 57555
+const AUTO_INCREMENT = 57556
+const APPROXNUM = 57557
+const SIGNED = 57558
+const UNSIGNED = 57559
+const ZEROFILL = 57560
+const COLLATION = 57561
+const DATABASES = 57562
+const SCHEMAS = 57563
+const TABLES = 57564
+const VITESS_KEYSPACES = 57565
+const VITESS_SHARDS = 57566
+const VITESS_TABLETS = 57567
+const VSCHEMA = 57568
+const VSCHEMA_TABLES = 57569
+const VITESS_TARGET = 57570
+const FULL = 57571
+const PROCESSLIST = 57572
+const COLUMNS = 57573
+const FIELDS = 57574
+const ENGINES = 57575
+const PLUGINS = 57576
+const NAMES = 57577
+const CHARSET = 57578
+const GLOBAL = 57579
+const SESSION = 57580
+const ISOLATION = 57581
+const LEVEL = 57582
+const READ = 57583
+const WRITE = 57584
+const ONLY = 57585
+const REPEATABLE = 57586
+const COMMITTED = 57587
+const UNCOMMITTED = 57588
+const SERIALIZABLE = 57589
+const CURRENT_TIMESTAMP = 57590
+const DATABASE = 57591
+const CURRENT_DATE = 57592
+const CURRENT_TIME = 57593
+const LOCALTIME = 57594
+const LOCALTIMESTAMP = 57595
+const UTC_DATE = 57596
+const UTC_TIME = 57597
+const UTC_TIMESTAMP = 57598
+const REPLACE = 57599
+const CONVERT = 57600
+const CAST = 57601
+const SUBSTR = 57602
+const SUBSTRING = 57603
+const GROUP_CONCAT = 57604
+const SEPARATOR = 57605
+const TIMESTAMPADD = 57606
+const TIMESTAMPDIFF = 57607
+const MATCH = 57608
+const AGAINST = 57609
+const BOOLEAN = 57610
+const LANGUAGE = 57611
+const WITH = 57612
+const QUERY = 57613
+const EXPANSION = 57614
+const UNUSED = 57615
 
 var yyToknames = [...]string{
 	"$end",
@@ -384,6 +394,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -402,6 +414,12 @@ var yyToknames = [...]string{
 	"DELAY",
 	"COUNTING",
 	"AFTER",
+	"OVER",
+	"RECURSIVE",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
 	"ALL",
 	"DISTINCT",
 	"AS",
@@ -673,1543 +691,1750 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 38,
-	173, 303,
-	174, 303,
-	-2, 293,
-	-1, 282,
-	124, 661,
-	-2, 657,
-	-1, 283,
-	124, 662,
-	-2, 658,
-	-1, 351,
-	90, 842,
-	-2, 68,
-	-1, 352,
-	90, 797,
-	-2, 69,
-	-1, 357,
-	90, 773,
-	-2, 623,
-	-1, 359,
-	90, 818,
-	-2, 625,
-	-1, 635,
-	46, 388,
-	51, 388,
-	53, 388,
-	-2, 350,
-	-1, 639,
-	1, 356,
-	7, 356,
-	12, 356,
-	13, 356,
-	14, 356,
-	15, 356,
-	17, 356,
-	19, 356,
-	34, 356,
-	35, 356,
-	46, 356,
-	47, 356,
-	48, 356,
-	49, 356,
-	50, 356,
-	51, 356,
-	52, 356,
-	53, 356,
-	54, 356,
-	57, 356,
-	58, 356,
-	60, 356,
-	61, 356,
-	170, 356,
-	283, 356,
-	-2, 383,
-	-1, 643,
-	58, 49,
-	60, 49,
-	-2, 53,
-	-1, 788,
-	124, 664,
-	-2, 660,
-	-1, 1025,
-	5, 35,
-	-2, 458,
-	-1, 1061,
-	46, 388,
-	51, 388,
-	53, 388,
-	-2, 351,
-	-1, 1289,
-	5, 35,
-	-2, 598,
-	-1, 1431,
-	5, 35,
-	-2, 601,
+	-1, 22,
+	5, 42,
+	6, 42,
+	7, 42,
+	-2, 602,
+	-1, 39,
+	181, 309,
+	182, 309,
+	-2, 299,
+	-1, 273,
+	5, 39,
+	6, 39,
+	-2, 602,
+	-1, 280,
+	5, 41,
+	6, 41,
+	7, 41,
+	-2, 602,
+	-1, 295,
+	132, 691,
+	-2, 687,
+	-1, 296,
+	132, 692,
+	-2, 688,
+	-1, 365,
+	98, 879,
+	-2, 74,
+	-1, 366,
+	98, 834,
+	-2, 75,
+	-1, 371,
+	98, 810,
+	-2, 653,
+	-1, 373,
+	98, 855,
+	-2, 655,
+	-1, 661,
+	54, 402,
+	59, 402,
+	61, 402,
+	-2, 362,
+	-1, 665,
+	1, 368,
+	5, 368,
+	6, 368,
+	7, 368,
+	9, 368,
+	14, 368,
+	15, 368,
+	16, 368,
+	17, 368,
+	19, 368,
+	21, 368,
+	42, 368,
+	43, 368,
+	54, 368,
+	55, 368,
+	56, 368,
+	57, 368,
+	58, 368,
+	59, 368,
+	60, 368,
+	61, 368,
+	62, 368,
+	65, 368,
+	66, 368,
+	68, 368,
+	69, 368,
+	178, 368,
+	291, 368,
+	-2, 397,
+	-1, 670,
+	66, 55,
+	68, 55,
+	-2, 59,
+	-1, 820,
+	132, 694,
+	-2, 690,
+	-1, 1063,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 472,
+	-1, 1100,
+	54, 402,
+	59, 402,
+	61, 402,
+	-2, 363,
+	-1, 1337,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 628,
+	-1, 1490,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 631,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 14407
+const yyLast = 16248
 
-var yyAct = [...]int{
-	283, 1471, 1155, 1481, 1443, 1058, 286, 595, 1330, 909,
-	1362, 1260, 1082, 313, 1417, 1196, 1317, 299, 1234, 62,
-	905, 1197, 884, 1080, 66, 1213, 879, 635, 1193, 288,
-	1059, 881, 988, 208, 258, 1088, 938, 66, 918, 817,
-	66, 908, 58, 1109, 356, 821, 1203, 249, 739, 832,
-	594, 3, 636, 1016, 829, 752, 1135, 524, 1126, 922,
-	870, 656, 790, 850, 952, 517, 314, 52, 458, 655,
-	948, 345, 350, 541, 533, 270, 342, 347, 645, 25,
-	571, 609, 863, 57, 932, 25, 1474, 1449, 1469, 1429,
-	1465, 1261, 1448, 250, 251, 252, 253, 610, 1428, 256,
-	25, 1185, 1281, 257, 463, 1228, 61, 1053, 1229, 1230,
-	1097, 1054, 642, 1096, 900, 901, 1098, 1346, 657, 52,
-	658, 571, 571, 899, 559, 210, 255, 212, 254, 511,
-	569, 1117, 55, 549, 931, 556, 1320, 572, 55, 464,
-	939, 571, 573, 574, 575, 576, 577, 578, 579, 220,
-	550, 555, 548, 55, 558, 557, 567, 568, 560, 561,
-	562, 563, 564, 565, 566, 559, 551, 553, 552, 554,
-	209, 569, 569, 22, 248, 500, 501, 546, 572, 572,
-	562, 563, 564, 565, 566, 559, 218, 214, 510, 215,
-	216, 569, 1158, 1157, 66, 208, 726, 1423, 572, 66,
-	325, 66, 331, 332, 329, 330, 328, 327, 326, 1467,
-	728, 66, 1461, 1418, 66, 1154, 333, 334, 1410, 188,
-	66, 211, 831, 66, 476, 208, 864, 208, 208, 507,
-	208, 208, 571, 208, 923, 208, 488, 508, 505, 506,
-	274, 1489, 1371, 477, 208, 727, 190, 191, 192, 193,
-	194, 465, 1151, 266, 212, 1159, 732, 719, 1153, 925,
-	1083, 1085, 1223, 66, 1222, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 208, 486, 1221,
-	461, 729, 569, 468, 925, 222, 213, 982, 1397, 572,
-	981, 487, 1292, 487, 487, 570, 487, 487, 1165, 487,
-	1485, 487, 513, 514, 1093, 1044, 344, 529, 1010, 217,
-	487, 460, 526, 462, 1110, 761, 1363, 530, 651, 545,
-	483, 1427, 1246, 469, 895, 906, 475, 540, 52, 1365,
-	528, 591, 482, 52, 1220, 484, 570, 570, 758, 197,
-	66, 66, 66, 466, 467, 925, 1084, 1408, 582, 208,
-	1463, 753, 23, 1372, 1370, 208, 570, 538, 23, 490,
-	265, 1380, 339, 340, 1152, 924, 1150, 990, 592, 527,
-	639, 1207, 634, 23, 540, 459, 276, 659, 198, 593,
-	1247, 597, 598, 599, 600, 601, 602, 603, 604, 605,
-	924, 608, 611, 611, 611, 617, 611, 611, 617, 611,
-	625, 626, 627, 628, 629, 630, 1364, 640, 612, 614,
-	616, 618, 620, 622, 623, 1455, 1483, 1187, 644, 1484,
-	851, 1482, 649, 721, 613, 615, 653, 619, 621, 797,
-	624, 353, 971, 492, 1490, 459, 494, 1115, 1413, 473,
-	754, 479, 480, 481, 795, 796, 794, 570, 1019, 535,
-	970, 924, 633, 989, 643, 760, 921, 919, 66, 920,
-	1029, 1435, 1028, 208, 917, 923, 491, 493, 66, 66,
-	208, 457, 764, 765, 66, 55, 1491, 66, 1030, 975,
-	66, 539, 538, 1456, 66, 793, 208, 531, 969, 1326,
-	208, 208, 208, 66, 208, 208, 851, 759, 1041, 540,
-	1325, 208, 208, 1130, 1129, 495, 496, 1118, 497, 498,
-	470, 499, 471, 502, 1437, 472, 539, 538, 1409, 1341,
-	539, 538, 512, 539, 538, 1323, 1162, 1189, 741, 487,
-	1127, 539, 538, 208, 540, 1406, 487, 66, 540, 928,
-	818, 540, 819, 208, 1263, 929, 966, 963, 964, 540,
-	962, 1110, 487, 733, 1105, 489, 487, 487, 487, 827,
-	487, 487, 791, 1099, 767, 1100, 738, 487, 487, 737,
-	667, 722, 823, 208, 720, 539, 538, 717, 792, 766,
-	723, 724, 973, 976, 485, 353, 730, 1368, 1466, 344,
-	788, 208, 736, 540, 786, 52, 780, 782, 783, 1007,
-	1008, 1009, 781, 1439, 516, 746, 478, 769, 516, 841,
-	844, 1368, 1421, 1368, 516, 852, 784, 1377, 968, 1368,
-	1398, 1368, 1367, 1315, 1314, 1376, 208, 208, 1294, 516,
-	1291, 516, 1243, 66, 1253, 1252, 520, 525, 1249, 1250,
-	967, 66, 1089, 66, 1249, 1248, 66, 66, 836, 776,
-	66, 66, 66, 208, 1023, 516, 647, 580, 1089, 52,
-	867, 516, 886, 926, 597, 59, 208, 834, 516, 866,
-	639, 666, 665, 1194, 1454, 639, 1206, 890, 1206, 639,
-	848, 892, 1168, 860, 972, 1142, 889, 834, 646, 1287,
-	867, 596, 1379, 647, 867, 867, 741, 1251, 1219, 974,
-	607, 1101, 898, 648, 1047, 650, 1206, 882, 883, 1046,
-	1023, 1023, 640, 267, 646, 1140, 640, 940, 941, 942,
-	66, 208, 893, 208, 897, 896, 888, 208, 208, 66,
-	66, 1023, 66, 66, 652, 913, 66, 208, 762, 731,
-	648, 718, 646, 262, 55, 865, 1450, 1332, 725, 933,
-	1302, 1239, 66, 1104, 66, 66, 953, 66, 949, 891,
-	934, 935, 936, 937, 742, 944, 55, 943, 743, 744,
-	745, 1156, 747, 748, 1446, 1445, 945, 946, 947, 749,
-	750, 954, 787, 855, 950, 951, 956, 487, 1476, 487,
-	1472, 1141, 1214, 1215, 1241, 1212, 1146, 1143, 1136, 1144,
-	1139, 1194, 1131, 487, 1137, 1138, 788, 756, 791, 1444,
-	997, 735, 775, 1074, 1217, 1216, 875, 876, 1145, 877,
-	998, 837, 838, 1063, 792, 843, 846, 847, 1064, 1071,
-	1065, 1069, 957, 1000, 1210, 1072, 1209, 1070, 1073, 271,
-	272, 979, 980, 1459, 983, 984, 1447, 1164, 985, 994,
-	859, 534, 861, 862, 1011, 1452, 1005, 1012, 1004, 518,
-	1285, 1122, 664, 873, 987, 1114, 532, 1415, 66, 993,
-	66, 66, 66, 1060, 1414, 519, 1344, 1112, 1106, 1328,
-	66, 521, 959, 66, 208, 734, 755, 880, 66, 353,
-	66, 268, 269, 1457, 263, 1061, 534, 639, 1067, 639,
-	639, 639, 910, 874, 872, 875, 876, 1087, 877, 208,
-	878, 259, 639, 1040, 1055, 777, 778, 516, 1102, 639,
-	1384, 1090, 1066, 260, 1068, 59, 1091, 1003, 1092, 1383,
-	1056, 1057, 1334, 836, 640, 1002, 640, 640, 640, 1075,
-	1089, 509, 1478, 1477, 187, 1035, 1034, 1032, 1031, 882,
-	571, 751, 1086, 536, 1478, 1394, 640, 208, 208, 1121,
-	1094, 1123, 1124, 1125, 1321, 757, 1111, 1468, 189, 56,
-	1, 1119, 1120, 1470, 596, 1262, 873, 839, 840, 1329,
-	1107, 1108, 965, 1416, 868, 1361, 208, 560, 561, 562,
-	563, 564, 565, 566, 559, 1233, 916, 1006, 787, 958,
-	569, 960, 66, 1128, 873, 907, 196, 572, 456, 1134,
-	195, 208, 1407, 915, 914, 986, 874, 872, 875, 876,
-	1147, 877, 1369, 878, 487, 1319, 1214, 1215, 927, 823,
-	1116, 823, 930, 1240, 1113, 1412, 904, 672, 670, 671,
-	669, 515, 674, 1161, 874, 872, 875, 876, 673, 877,
-	668, 878, 487, 1022, 233, 348, 660, 208, 208, 1195,
-	1060, 955, 537, 66, 199, 1149, 1171, 1172, 1198, 1179,
-	1148, 1038, 278, 1177, 1178, 961, 1180, 503, 504, 235,
-	581, 1186, 1001, 1095, 354, 1201, 1442, 208, 1422, 788,
-	763, 523, 639, 997, 1205, 1382, 1333, 1039, 606, 849,
-	287, 779, 208, 300, 208, 208, 297, 1200, 298, 770,
-	284, 1052, 547, 1232, 1166, 1208, 285, 279, 638, 631,
-	871, 1199, 869, 52, 1062, 343, 1227, 1211, 1298, 640,
-	1305, 1225, 66, 1224, 1078, 1079, 995, 996, 637, 525,
-	1244, 1245, 1167, 1231, 1236, 910, 1280, 1389, 774, 66,
-	27, 1237, 1238, 186, 273, 208, 19, 18, 208, 208,
-	66, 17, 20, 16, 15, 570, 208, 14, 474, 66,
-	31, 583, 584, 585, 586, 587, 588, 589, 590, 21,
-	13, 12, 11, 1255, 10, 9, 8, 7, 6, 5,
-	4, 60, 261, 264, 1267, 1256, 24, 1258, 639, 2,
-	1269, 0, 0, 1268, 0, 0, 0, 0, 0, 0,
-	0, 1024, 0, 1060, 0, 0, 0, 0, 0, 0,
-	208, 0, 0, 0, 1286, 0, 0, 0, 1042, 0,
-	312, 0, 208, 0, 1299, 640, 1133, 1296, 0, 0,
-	208, 1102, 0, 1304, 1254, 1303, 0, 1170, 1295, 0,
-	0, 0, 1279, 0, 0, 208, 0, 0, 0, 0,
-	1313, 1257, 208, 206, 1160, 0, 0, 0, 0, 0,
-	0, 0, 1266, 0, 0, 0, 0, 0, 0, 0,
-	0, 1190, 0, 0, 0, 1322, 0, 1324, 1309, 1310,
-	1311, 0, 208, 208, 0, 208, 0, 0, 0, 0,
-	0, 1198, 0, 208, 66, 0, 0, 0, 0, 1345,
-	208, 208, 208, 66, 768, 0, 208, 0, 0, 1353,
-	1316, 487, 0, 0, 0, 0, 1357, 1358, 1359, 0,
-	1352, 0, 886, 208, 1366, 1360, 0, 0, 910, 1373,
-	910, 1347, 0, 1374, 0, 1375, 0, 0, 0, 1381,
-	0, 0, 0, 0, 1199, 0, 66, 1348, 0, 0,
-	1198, 0, 1395, 1400, 0, 0, 0, 1163, 0, 208,
-	0, 0, 1405, 1404, 1355, 1356, 0, 0, 833, 835,
-	208, 208, 0, 1399, 0, 639, 0, 0, 1419, 0,
-	1420, 0, 1425, 0, 0, 1378, 0, 0, 208, 1396,
-	1430, 1060, 1170, 0, 0, 0, 0, 0, 0, 0,
-	0, 66, 0, 1199, 0, 52, 0, 0, 1188, 208,
-	0, 0, 640, 0, 1441, 355, 0, 0, 0, 789,
-	0, 0, 798, 799, 800, 801, 802, 803, 804, 805,
-	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
-	816, 208, 820, 1453, 1451, 355, 0, 355, 355, 1462,
-	355, 355, 0, 355, 0, 355, 1226, 1460, 910, 0,
-	0, 0, 1475, 0, 355, 278, 0, 0, 0, 0,
-	278, 278, 1486, 0, 278, 278, 278, 0, 0, 0,
-	0, 0, 0, 856, 0, 0, 0, 0, 1331, 0,
-	0, 0, 0, 0, 571, 0, 0, 543, 0, 278,
-	278, 278, 278, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1436, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1327, 1473, 1390, 0, 558, 557, 567,
-	568, 560, 561, 562, 563, 564, 565, 566, 559, 0,
-	999, 0, 0, 0, 569, 0, 0, 0, 0, 0,
-	0, 572, 0, 0, 0, 0, 1282, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 596, 0, 0, 355,
-	0, 0, 0, 0, 1297, 661, 0, 0, 0, 1300,
-	0, 1301, 0, 0, 0, 0, 0, 1306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1020, 0, 1021, 0, 0, 1331, 910, 0, 0,
-	1025, 1026, 1027, 0, 0, 0, 0, 1033, 0, 0,
-	1036, 1037, 0, 0, 0, 0, 1043, 0, 0, 1284,
-	1045, 0, 0, 1048, 1049, 1050, 1051, 0, 571, 0,
-	0, 0, 0, 0, 0, 0, 278, 0, 0, 0,
-	0, 0, 0, 0, 0, 1077, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1013, 1014, 1015,
-	0, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 355, 0, 0, 0, 0, 569, 0,
-	355, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 278, 0, 1391, 0, 355, 0, 0, 570,
-	355, 355, 355, 0, 355, 355, 0, 0, 0, 0,
-	278, 355, 355, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 25, 26, 53, 28, 29, 0, 0, 0, 0,
-	1424, 596, 0, 771, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 543, 44, 522, 355, 0, 0, 30,
-	49, 50, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
-	39, 0, 516, 826, 55, 0, 1176, 0, 0, 0,
-	571, 0, 221, 0, 0, 247, 0, 0, 0, 0,
-	0, 828, 0, 1458, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1464, 0, 0, 0, 0, 853,
-	0, 0, 0, 558, 557, 567, 568, 560, 561, 562,
-	563, 564, 565, 566, 559, 0, 857, 858, 0, 0,
-	569, 0, 1218, 570, 0, 0, 0, 572, 0, 0,
-	0, 0, 0, 32, 33, 35, 34, 37, 0, 51,
-	0, 0, 0, 355, 0, 0, 0, 0, 1283, 0,
-	0, 0, 0, 278, 0, 0, 355, 571, 0, 1174,
-	1175, 38, 45, 46, 0, 278, 47, 48, 36, 0,
-	0, 0, 0, 1181, 1182, 0, 1183, 1184, 0, 0,
-	0, 40, 41, 0, 42, 43, 0, 0, 1191, 1192,
-	558, 557, 567, 568, 560, 561, 562, 563, 564, 565,
-	566, 559, 0, 0, 0, 0, 0, 569, 0, 0,
-	0, 355, 0, 355, 572, 1270, 0, 977, 978, 0,
-	0, 0, 1272, 1273, 1274, 0, 277, 355, 0, 346,
-	0, 0, 0, 0, 221, 0, 221, 0, 0, 0,
-	0, 0, 0, 1288, 1289, 1290, 221, 1293, 0, 221,
-	0, 0, 355, 0, 0, 221, 1242, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 571, 0, 1312, 0,
-	0, 54, 0, 0, 0, 0, 0, 0, 549, 0,
-	556, 0, 0, 0, 23, 570, 0, 573, 574, 575,
-	576, 577, 578, 579, 0, 550, 555, 548, 63, 558,
-	557, 567, 568, 560, 561, 562, 563, 564, 565, 566,
-	559, 551, 553, 552, 554, 1271, 569, 0, 0, 0,
-	0, 0, 0, 572, 1340, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 570, 0, 1081, 221, 221, 221, 1278, 1385,
-	1386, 1387, 1388, 0, 0, 0, 1392, 1393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	0, 1401, 1402, 1403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1335, 1336, 1337,
-	1338, 1339, 0, 0, 0, 1342, 1343, 0, 571, 0,
-	0, 0, 0, 1426, 0, 0, 0, 0, 0, 0,
-	1431, 0, 0, 1433, 1434, 0, 0, 1132, 355, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1438, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 0, 0, 1277, 355, 0, 569, 0,
-	0, 570, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 355, 0, 221, 221, 0, 0, 0, 0, 221,
-	0, 0, 221, 0, 0, 221, 0, 0, 0, 740,
-	0, 0, 0, 1487, 1488, 571, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 355, 0, 0, 0, 0,
-	0, 0, 0, 0, 853, 0, 0, 1202, 1204, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 557,
-	567, 568, 560, 561, 562, 563, 564, 565, 566, 559,
-	0, 0, 221, 0, 0, 569, 0, 1204, 0, 0,
-	0, 740, 572, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 355, 0, 355, 1235, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1479, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	0, 0, 0, 0, 277, 277, 0, 0, 277, 277,
-	277, 0, 0, 570, 854, 1259, 0, 0, 1264, 1265,
-	0, 0, 0, 0, 0, 0, 355, 0, 0, 0,
-	0, 0, 0, 277, 277, 277, 277, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 221, 571, 63, 0,
-	0, 221, 221, 0, 0, 221, 894, 740, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1081, 557, 567, 568, 560, 561, 562, 563, 564, 565,
-	566, 559, 355, 0, 0, 0, 0, 569, 1276, 0,
-	1318, 0, 0, 0, 572, 0, 0, 0, 0, 0,
-	570, 0, 0, 0, 0, 355, 0, 0, 0, 0,
-	0, 0, 355, 0, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 221, 0, 221, 221, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 571, 0,
-	0, 0, 1349, 1350, 0, 1351, 0, 221, 0, 991,
-	992, 0, 221, 1318, 0, 689, 0, 740, 0, 0,
-	1318, 1318, 1318, 0, 0, 0, 1235, 0, 0, 0,
-	277, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 1318, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1411,
-	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 677, 0, 0, 277, 853, 0, 0, 1432, 0,
-	0, 0, 570, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 854, 221, 0, 221, 221, 221, 0, 1440,
-	0, 0, 1275, 0, 0, 1076, 0, 0, 221, 0,
-	690, 0, 0, 63, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1318, 703, 706, 707, 708, 709, 710, 711, 0,
-	712, 713, 714, 715, 716, 691, 692, 693, 694, 675,
-	676, 704, 571, 678, 0, 679, 680, 681, 682, 683,
-	684, 685, 686, 687, 688, 695, 696, 697, 698, 699,
-	700, 701, 702, 0, 0, 0, 571, 0, 0, 0,
-	0, 0, 0, 570, 0, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 0, 0, 0,
-	0, 0, 569, 0, 0, 0, 0, 0, 0, 572,
-	0, 567, 568, 560, 561, 562, 563, 564, 565, 566,
-	559, 571, 0, 0, 0, 0, 569, 221, 705, 0,
-	0, 0, 1173, 572, 0, 0, 0, 277, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	0, 0, 0, 0, 558, 557, 567, 568, 560, 561,
-	562, 563, 564, 565, 566, 559, 0, 0, 0, 0,
-	740, 569, 0, 0, 0, 0, 0, 0, 572, 854,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 542, 0,
-	0, 0, 0, 91, 0, 0, 0, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 544, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 539, 538, 0, 0, 0, 0, 221, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 570, 0, 540,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-	0, 570, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 854, 143, 131, 165, 570, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 1354,
-	571, 0, 0, 0, 0, 0, 0, 0, 63, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 68, 75,
-	110, 0, 138, 95, 168, 0, 0, 0, 0, 571,
-	0, 0, 0, 558, 557, 567, 568, 560, 561, 562,
-	563, 564, 565, 566, 559, 0, 0, 0, 0, 0,
-	569, 221, 854, 0, 0, 0, 0, 572, 0, 0,
-	0, 0, 558, 557, 567, 568, 560, 561, 562, 563,
-	564, 565, 566, 559, 0, 0, 0, 0, 0, 569,
-	0, 0, 0, 0, 0, 0, 572, 0, 0, 0,
-	854, 1017, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 443, 431, 221, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 207, 0, 911, 912, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 1103,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 570, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 570, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 911, 912, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	443, 431, 0, 402, 446, 381, 394, 454, 395, 396,
-	424, 367, 410, 129, 392, 182, 89, 85, 67, 0,
-	384, 362, 389, 363, 382, 404, 91, 407, 380, 433,
-	413, 445, 109, 452, 111, 418, 0, 150, 120, 0,
-	0, 406, 435, 0, 408, 429, 401, 425, 372, 417,
-	447, 393, 422, 448, 55, 0, 0, 207, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	420, 442, 391, 421, 423, 361, 419, 0, 365, 368,
-	453, 437, 387, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 405, 409, 426, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 385, 0, 416, 0, 0,
-	0, 0, 0, 0, 369, 366, 0, 0, 403, 0,
-	0, 0, 0, 371, 0, 386, 427, 0, 360, 98,
-	430, 436, 0, 400, 172, 440, 398, 397, 444, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 434, 383, 390, 86, 388, 143, 131, 165, 415,
-	132, 142, 112, 158, 137, 441, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 364, 0,
-	151, 167, 185, 80, 379, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 375, 378, 373, 374, 411, 412, 449, 450,
-	451, 428, 370, 0, 376, 377, 0, 432, 438, 439,
-	414, 68, 75, 110, 455, 138, 95, 168, 443, 431,
-	0, 402, 446, 381, 394, 454, 395, 396, 424, 367,
-	410, 129, 392, 182, 89, 85, 67, 0, 384, 362,
-	389, 363, 382, 404, 91, 407, 380, 433, 413, 445,
-	109, 452, 111, 418, 0, 150, 120, 0, 0, 406,
-	435, 0, 408, 429, 401, 425, 372, 417, 447, 393,
-	422, 448, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 420, 442,
-	391, 421, 423, 361, 419, 0, 365, 368, 453, 437,
-	387, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	405, 409, 426, 399, 0, 0, 0, 0, 0, 0,
-	0, 1169, 0, 385, 0, 416, 0, 0, 0, 0,
-	0, 0, 369, 366, 0, 0, 403, 0, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 420, 442, 391, 421,
-	423, 361, 419, 0, 365, 368, 453, 437, 387, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 405, 409,
-	426, 399, 0, 0, 0, 0, 0, 0, 0, 895,
-	0, 385, 0, 416, 0, 0, 0, 0, 0, 0,
-	369, 366, 0, 0, 403, 0, 0, 0, 0, 371,
-	0, 386, 427, 0, 360, 98, 430, 436, 0, 400,
-	172, 440, 398, 397, 444, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 434, 383, 390,
-	86, 388, 143, 131, 165, 415, 132, 142, 112, 158,
-	137, 441, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 364, 0, 151, 167, 185, 80,
-	379, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 375, 378,
-	373, 374, 411, 412, 449, 450, 451, 428, 370, 0,
-	376, 377, 0, 432, 438, 439, 414, 68, 75, 110,
-	455, 138, 95, 168, 443, 431, 0, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 785, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	443, 431, 0, 402, 446, 381, 394, 454, 395, 396,
-	424, 367, 410, 129, 392, 182, 89, 85, 67, 0,
-	384, 362, 389, 363, 382, 404, 91, 407, 380, 433,
-	413, 445, 109, 452, 111, 418, 0, 150, 120, 0,
-	0, 406, 435, 0, 408, 429, 401, 425, 372, 417,
-	447, 393, 422, 448, 0, 0, 0, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	420, 442, 391, 421, 423, 361, 419, 0, 365, 368,
-	453, 437, 387, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 405, 409, 426, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 385, 0, 416, 0, 0,
-	0, 0, 0, 0, 369, 366, 0, 0, 403, 0,
-	0, 0, 0, 371, 0, 386, 427, 0, 360, 98,
-	430, 436, 0, 400, 172, 440, 398, 397, 444, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 434, 383, 390, 86, 388, 143, 131, 165, 415,
-	132, 142, 112, 158, 137, 441, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 364, 0,
-	151, 167, 185, 80, 379, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 375, 378, 373, 374, 411, 412, 449, 450,
-	451, 428, 370, 0, 376, 377, 0, 432, 438, 439,
-	414, 68, 75, 110, 455, 138, 95, 168, 443, 431,
-	0, 402, 446, 381, 394, 454, 395, 396, 424, 367,
-	410, 129, 392, 182, 89, 85, 67, 0, 384, 362,
-	389, 363, 382, 404, 91, 407, 380, 433, 413, 445,
-	109, 452, 111, 418, 0, 150, 120, 0, 0, 406,
-	435, 0, 408, 429, 401, 425, 372, 417, 447, 393,
-	422, 448, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 420, 442,
-	391, 421, 423, 361, 419, 0, 365, 368, 453, 437,
-	387, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	405, 409, 426, 399, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 385, 0, 416, 0, 0, 0, 0,
-	0, 0, 369, 366, 0, 0, 403, 0, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 358, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 359, 357, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 420, 442, 391, 421,
-	423, 361, 419, 0, 365, 368, 453, 437, 387, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 405, 409,
-	426, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 385, 0, 416, 0, 0, 0, 0, 0, 0,
-	369, 366, 0, 0, 403, 0, 0, 0, 0, 371,
-	0, 386, 427, 0, 360, 98, 430, 436, 0, 400,
-	172, 440, 398, 397, 444, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 434, 383, 390,
-	86, 388, 143, 131, 165, 415, 132, 142, 112, 158,
-	137, 441, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 364, 0, 151, 167, 185, 80,
-	379, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 375, 378,
-	373, 374, 411, 412, 449, 450, 451, 428, 370, 0,
-	376, 377, 0, 432, 438, 439, 414, 68, 75, 110,
-	455, 138, 95, 168, 443, 431, 0, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 654, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	358, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 359, 357, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 349, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 358, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 359, 357, 352, 351, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 301,
-	0, 0, 0, 91, 0, 281, 0, 0, 0, 109,
-	324, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 315, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 55, 0, 0, 282, 303, 302, 305, 306, 307,
-	308, 0, 0, 82, 304, 0, 0, 309, 310, 311,
-	0, 0, 0, 280, 295, 0, 323, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
-	0, 0, 0, 0, 337, 0, 294, 0, 0, 0,
-	0, 0, 289, 290, 291, 296, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 1307, 1308,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 301, 0, 0, 0, 91, 0,
-	281, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 902, 0, 55, 0, 0, 282,
-	303, 302, 305, 306, 307, 308, 0, 0, 82, 304,
-	0, 0, 309, 310, 311, 903, 0, 0, 280, 295,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 292, 293, 0, 0, 0, 0, 337,
-	0, 294, 0, 0, 0, 0, 0, 289, 290, 291,
-	296, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 335,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 325, 336, 331, 332, 329, 330,
-	328, 327, 326, 338, 317, 318, 319, 320, 322, 25,
-	333, 334, 321, 68, 75, 110, 0, 138, 95, 168,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 23, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 830, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 516, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 845, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 275, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 842, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 0, 91,
-	0, 0, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 0,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 0, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 1480, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 516, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 0, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 0, 91,
-	0, 0, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 0,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 0, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 571, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 0, 0, 0,
-	0, 0, 569, 0, 0, 0, 0, 0, 0, 572,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 68,
-	75, 110, 91, 138, 95, 168, 0, 570, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 571, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 1018,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 0, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 203, 204, 0, 0,
-	200, 0, 0, 0, 205, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 25, 570, 0, 0, 0, 68, 75, 110,
-	0, 138, 95, 168, 129, 0, 182, 89, 85, 67,
-	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 0, 0, 109, 0, 111, 0, 0, 150, 120,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 55, 0, 0, 207, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 25, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 68, 75, 110, 23, 138, 95, 168, 91,
-	0, 0, 0, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	641, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 75, 110, 23, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 887,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 65, 0, 64, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 68,
-	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 822, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 824, 825, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 0, 0, 887, 0, 68, 75, 110,
-	91, 138, 95, 168, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 65, 0, 64, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 885, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 0, 772, 0, 0, 773, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 68, 75, 110, 0, 138, 95, 168,
-	91, 0, 663, 0, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 207, 0, 662, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 0, 641,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 68, 75, 110, 91, 138, 95, 168,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 65, 0, 64,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 0, 0, 0, 86, 0, 143, 131, 165, 0,
-	132, 142, 112, 158, 137, 0, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 0, 0,
-	151, 167, 185, 80, 0, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 68, 75, 110, 91, 138, 95, 168, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 544, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+var yyAct = [...]int16{
+	327, 56, 1559, 1536, 1548, 620, 1501, 1305, 1365, 509,
+	1479, 1196, 1470, 299, 1097, 943, 1413, 1378, 1123, 1240,
+	326, 312, 661, 60, 1351, 939, 1279, 1241, 912, 1256,
+	1119, 619, 3, 65, 918, 972, 1237, 1098, 1022, 942,
+	1246, 662, 952, 853, 265, 551, 766, 864, 849, 256,
+	1150, 507, 1054, 861, 370, 779, 1176, 56, 1167, 1117,
+	683, 903, 883, 914, 822, 966, 272, 956, 545, 982,
+	538, 479, 986, 682, 364, 359, 896, 558, 566, 301,
+	22, 283, 672, 53, 635, 356, 59, 1552, 264, 1511,
+	1129, 361, 1546, 1488, 1539, 1306, 257, 258, 259, 260,
+	1510, 596, 263, 596, 1487, 1229, 596, 1329, 484, 1005,
+	64, 1274, 1275, 574, 1273, 581, 193, 636, 511, 934,
+	935, 933, 598, 599, 600, 601, 602, 603, 604, 262,
+	575, 580, 573, 1004, 583, 582, 592, 593, 585, 586,
+	587, 588, 589, 590, 591, 584, 576, 578, 577, 579,
+	584, 594, 684, 594, 685, 532, 594, 571, 597, 26,
+	597, 268, 1009, 597, 261, 26, 596, 217, 1158, 219,
+	339, 1003, 345, 346, 343, 344, 342, 341, 340, 1138,
+	528, 965, 1137, 1368, 26, 1139, 347, 348, 529, 526,
+	527, 56, 513, 1092, 56, 515, 973, 1093, 255, 863,
+	582, 592, 593, 585, 586, 587, 588, 589, 590, 591,
+	584, 367, 521, 522, 531, 296, 594, 755, 57, 1199,
+	25, 1476, 1398, 597, 57, 512, 514, 1541, 1198, 1000,
+	997, 998, 508, 996, 508, 508, 753, 508, 508, 69,
+	508, 1528, 508, 57, 1471, 287, 1463, 1386, 1195, 215,
+	897, 508, 754, 69, 225, 221, 69, 222, 223, 957,
+	1567, 1200, 498, 218, 486, 1007, 1010, 1414, 219, 56,
+	759, 274, 550, 959, 274, 1124, 1126, 280, 195, 69,
+	1416, 485, 746, 216, 1268, 516, 517, 1267, 518, 519,
+	1266, 520, 482, 523, 497, 607, 756, 553, 489, 229,
+	547, 1002, 533, 1422, 556, 220, 197, 198, 199, 200,
+	201, 534, 535, 1208, 510, 617, 595, 1016, 595, 1206,
+	1015, 595, 1134, 1001, 1083, 1048, 618, 1486, 622, 623,
+	624, 625, 626, 627, 628, 629, 630, 631, 797, 634,
+	637, 637, 637, 643, 637, 637, 643, 637, 651, 652,
+	653, 654, 655, 656, 788, 666, 678, 1415, 570, 504,
+	929, 1125, 596, 940, 1291, 1264, 1263, 1006, 785, 565,
+	1461, 1431, 367, 1250, 574, 494, 581, 224, 686, 958,
+	548, 595, 1008, 598, 599, 600, 601, 602, 603, 604,
+	554, 575, 580, 573, 616, 583, 582, 592, 593, 585,
+	586, 587, 588, 589, 590, 591, 584, 576, 578, 577,
+	579, 273, 594, 780, 1423, 1421, 69, 215, 660, 597,
+	480, 69, 1292, 69, 1530, 638, 640, 642, 644, 646,
+	648, 649, 549, 69, 665, 959, 69, 596, 23, 1231,
+	676, 671, 69, 748, 23, 69, 491, 215, 492, 215,
+	215, 493, 215, 215, 680, 215, 478, 215, 639, 641,
+	596, 645, 647, 23, 650, 1151, 215, 884, 1444, 1563,
+	583, 582, 592, 593, 585, 586, 587, 588, 589, 590,
+	591, 584, 353, 354, 508, 69, 596, 594, 215, 563,
+	1156, 508, 487, 488, 597, 592, 593, 585, 586, 587,
+	588, 589, 590, 591, 584, 215, 565, 508, 781, 1466,
+	594, 508, 508, 508, 204, 508, 508, 597, 500, 501,
+	502, 560, 508, 508, 57, 587, 588, 589, 590, 591,
+	584, 829, 1024, 1493, 825, 745, 594, 1568, 564, 563,
+	1374, 958, 752, 597, 1373, 1233, 827, 828, 826, 1069,
+	56, 56, 1171, 205, 555, 56, 565, 1170, 769, 768,
+	1517, 1519, 770, 771, 772, 1159, 774, 775, 1459, 69,
+	69, 69, 596, 776, 777, 791, 792, 595, 215, 1569,
+	819, 793, 794, 760, 215, 1561, 1192, 884, 1562, 1080,
+	1560, 962, 1194, 850, 823, 851, 1495, 963, 798, 1462,
+	1393, 801, 564, 563, 1371, 583, 582, 592, 593, 585,
+	586, 587, 588, 589, 590, 591, 584, 818, 56, 1203,
+	565, 596, 594, 622, 1023, 820, 564, 563, 1140, 597,
+	1141, 1168, 564, 563, 1518, 274, 1308, 800, 799, 812,
+	814, 815, 874, 877, 565, 813, 816, 1445, 885, 1151,
+	565, 1146, 595, 824, 868, 1045, 1046, 1047, 585, 586,
+	587, 588, 589, 590, 591, 584, 859, 915, 916, 917,
+	765, 594, 764, 666, 749, 595, 747, 666, 597, 1544,
+	537, 1419, 1540, 866, 537, 1497, 537, 1419, 1474, 367,
+	744, 787, 506, 1068, 69, 1067, 1419, 537, 1193, 215,
+	1191, 595, 944, 920, 69, 69, 215, 499, 893, 881,
+	69, 1419, 1451, 69, 564, 563, 69, 1419, 1418, 537,
+	69, 1428, 215, 1363, 1362, 1427, 215, 215, 215, 69,
+	215, 215, 565, 768, 1340, 537, 924, 215, 215, 786,
+	926, 796, 537, 974, 975, 976, 665, 922, 508, 674,
+	508, 1183, 665, 927, 930, 931, 665, 1288, 564, 563,
+	1298, 1297, 1294, 1295, 508, 947, 674, 959, 968, 969,
+	970, 971, 1130, 215, 1294, 1293, 565, 69, 1061, 537,
+	1130, 1181, 61, 215, 979, 980, 981, 595, 1057, 900,
+	537, 906, 693, 692, 1211, 960, 899, 480, 819, 992,
+	1238, 994, 675, 1249, 677, 923, 1516, 673, 1249, 866,
+	984, 985, 855, 215, 1508, 1020, 988, 1505, 1335, 675,
+	1049, 673, 900, 869, 870, 871, 900, 1430, 876, 879,
+	880, 215, 900, 1061, 1249, 1031, 595, 907, 905, 908,
+	909, 1296, 910, 820, 911, 1262, 1142, 823, 932, 1061,
+	57, 537, 1086, 892, 1085, 894, 895, 1182, 1032, 1061,
+	673, 679, 1187, 1184, 1177, 1185, 1180, 215, 215, 1037,
+	1178, 1179, 1038, 958, 69, 789, 275, 758, 955, 953,
+	269, 954, 69, 906, 1186, 69, 951, 957, 69, 69,
+	57, 1050, 69, 69, 69, 215, 1512, 1095, 1096, 1380,
+	271, 666, 967, 666, 666, 666, 824, 1348, 215, 1504,
+	1503, 1257, 1258, 1099, 1284, 1145, 915, 987, 983, 888,
+	1127, 978, 977, 1100, 666, 1197, 1106, 990, 1094, 907,
+	905, 908, 909, 57, 910, 57, 911, 1554, 1549, 1257,
+	1258, 1286, 1255, 1238, 1105, 1079, 1107, 868, 1172, 783,
+	1502, 762, 944, 1113, 62, 1128, 908, 909, 1143, 910,
+	807, 1102, 69, 215, 1260, 215, 1103, 1259, 1104, 215,
+	215, 69, 69, 1114, 69, 69, 1253, 1122, 69, 215,
+	665, 906, 665, 665, 665, 1110, 289, 1131, 1252, 1112,
+	1526, 1111, 508, 1135, 69, 665, 69, 69, 1162, 69,
+	1164, 1165, 1166, 665, 1160, 1161, 1044, 1152, 1108, 284,
+	285, 1509, 215, 215, 1109, 1132, 1205, 1133, 270, 1028,
+	508, 559, 1148, 1149, 1514, 1043, 1042, 907, 905, 908,
+	909, 539, 910, 1163, 911, 1207, 557, 1169, 691, 1155,
+	1468, 1467, 1396, 1174, 1153, 1175, 1147, 1333, 993, 1376,
+	761, 913, 1034, 540, 1188, 281, 282, 559, 1213, 278,
+	279, 1219, 1060, 276, 277, 1520, 1041, 266, 1438, 1435,
+	194, 1201, 267, 61, 1040, 542, 1434, 1202, 1074, 1383,
+	61, 1077, 1130, 530, 1556, 1555, 194, 1073, 1071, 1070,
+	778, 561, 1556, 1234, 1243, 1448, 56, 1369, 784, 1542,
+	196, 1230, 666, 1214, 1215, 58, 1099, 1239, 1, 1221,
+	1547, 1222, 1307, 1224, 1242, 1377, 69, 1223, 69, 69,
+	69, 191, 192, 999, 1469, 901, 1412, 1244, 69, 1278,
+	1031, 69, 215, 215, 950, 941, 203, 69, 820, 69,
+	477, 1245, 202, 1251, 1460, 949, 948, 1420, 1367, 961,
+	1157, 964, 944, 1270, 944, 1285, 1154, 1465, 215, 699,
+	1277, 697, 698, 696, 701, 700, 695, 240, 1269, 362,
+	687, 989, 562, 206, 1190, 1189, 995, 524, 525, 242,
+	606, 665, 1276, 1039, 536, 1136, 1289, 1290, 1248, 1281,
+	368, 1500, 1475, 790, 1385, 1384, 544, 1433, 1535, 1282,
+	1283, 1478, 1382, 1078, 632, 882, 215, 215, 56, 300,
+	811, 313, 666, 310, 311, 802, 1213, 297, 1091, 572,
+	1319, 298, 1272, 292, 664, 657, 1300, 904, 902, 1101,
+	1327, 357, 1254, 1344, 1118, 215, 663, 1210, 1301, 1313,
+	1303, 1312, 1328, 1443, 806, 28, 190, 286, 19, 18,
+	17, 20, 16, 541, 546, 69, 15, 14, 495, 32,
+	21, 13, 12, 1099, 215, 11, 10, 1357, 1358, 1359,
+	9, 8, 1341, 291, 7, 1316, 1334, 6, 5, 4,
+	605, 1345, 24, 855, 2, 855, 944, 1342, 0, 1355,
+	1349, 665, 1143, 1350, 1356, 0, 0, 0, 0, 0,
+	508, 1315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 215, 215, 0, 621, 1361, 1379, 69, 0, 0,
+	0, 0, 0, 0, 633, 0, 0, 0, 0, 1370,
+	0, 1372, 0, 0, 0, 0, 1243, 0, 0, 1400,
+	0, 0, 0, 215, 0, 0, 1364, 0, 0, 0,
+	0, 1375, 0, 0, 1405, 1406, 1242, 1403, 215, 0,
+	215, 215, 0, 1397, 0, 0, 1408, 1409, 1410, 0,
+	1399, 0, 0, 0, 0, 0, 0, 1429, 608, 609,
+	610, 611, 612, 613, 614, 615, 1402, 0, 69, 1432,
+	1417, 1424, 0, 0, 920, 1411, 1425, 0, 1426, 1243,
+	0, 56, 0, 0, 0, 69, 666, 0, 1332, 1437,
+	0, 215, 0, 0, 215, 215, 69, 596, 1449, 1242,
+	1453, 0, 215, 0, 215, 0, 0, 69, 1452, 1458,
+	1457, 0, 1450, 0, 0, 0, 0, 0, 0, 0,
+	1379, 944, 0, 0, 1484, 0, 0, 1473, 1472, 0,
+	583, 582, 592, 593, 585, 586, 587, 588, 589, 590,
+	591, 584, 1099, 1489, 0, 0, 0, 594, 0, 0,
+	0, 795, 0, 0, 597, 0, 0, 0, 215, 0,
+	0, 0, 1506, 1507, 625, 665, 1499, 0, 0, 0,
+	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 0, 0, 0, 0, 0, 1515, 0, 1525,
+	1513, 0, 1523, 1524, 0, 215, 1522, 782, 0, 669,
+	0, 1527, 215, 1529, 0, 0, 1537, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 622, 865,
+	867, 537, 1550, 0, 0, 1537, 0, 0, 1551, 596,
+	809, 810, 1553, 0, 0, 0, 215, 227, 0, 1564,
+	0, 0, 0, 0, 215, 69, 0, 0, 0, 0,
+	0, 0, 69, 215, 215, 215, 69, 0, 0, 215,
+	0, 0, 583, 582, 592, 593, 585, 586, 587, 588,
+	589, 590, 591, 584, 0, 0, 215, 0, 0, 594,
+	0, 0, 0, 0, 0, 0, 597, 0, 0, 621,
+	0, 0, 0, 872, 873, 0, 0, 0, 0, 0,
+	0, 69, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 595, 0, 0, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 215, 215, 821, 0,
+	0, 830, 831, 832, 833, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
+	0, 852, 0, 938, 0, 0, 0, 0, 0, 69,
+	0, 0, 0, 0, 0, 325, 0, 215, 26, 27,
+	54, 29, 30, 0, 291, 0, 0, 0, 0, 291,
+	291, 291, 0, 0, 291, 291, 291, 0, 0, 1331,
+	0, 0, 0, 889, 1033, 0, 0, 45, 596, 213,
+	358, 0, 31, 50, 51, 481, 0, 483, 215, 291,
+	291, 291, 291, 0, 0, 0, 0, 490, 0, 0,
+	496, 0, 0, 40, 0, 0, 503, 57, 0, 505,
+	0, 583, 582, 592, 593, 585, 586, 587, 588, 589,
+	590, 591, 584, 0, 595, 0, 0, 0, 594, 0,
+	0, 0, 0, 1029, 1030, 597, 546, 0, 0, 1058,
+	0, 1059, 0, 0, 0, 0, 0, 0, 1063, 1064,
+	1065, 1066, 0, 0, 0, 0, 1072, 0, 0, 1075,
+	1076, 1326, 0, 0, 0, 1082, 0, 0, 0, 1084,
+	0, 0, 1087, 1088, 1089, 1090, 33, 34, 36, 35,
+	38, 0, 52, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 39, 46, 47, 0, 0, 48,
+	49, 37, 0, 1062, 0, 0, 0, 596, 0, 0,
+	0, 0, 0, 0, 41, 42, 0, 43, 44, 0,
+	0, 1081, 0, 659, 0, 670, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 0, 0, 369, 0, 0,
+	583, 582, 592, 593, 585, 586, 587, 588, 589, 590,
+	591, 584, 0, 1051, 1052, 1053, 596, 594, 0, 0,
+	0, 0, 0, 0, 597, 0, 0, 369, 0, 369,
+	369, 0, 369, 369, 0, 369, 0, 369, 0, 0,
+	0, 0, 1325, 595, 0, 0, 369, 0, 291, 583,
+	582, 592, 593, 585, 586, 587, 588, 589, 590, 591,
+	584, 0, 0, 0, 55, 0, 594, 291, 552, 0,
+	0, 0, 0, 597, 0, 0, 0, 23, 0, 0,
+	0, 0, 0, 0, 0, 568, 0, 0, 0, 0,
+	1220, 0, 0, 0, 0, 0, 0, 0, 596, 0,
+	0, 0, 0, 1324, 0, 0, 0, 1055, 694, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 750, 751,
+	0, 1204, 0, 0, 757, 0, 0, 358, 0, 0,
+	763, 583, 582, 592, 593, 585, 586, 587, 588, 589,
+	590, 591, 584, 773, 0, 0, 0, 1261, 594, 0,
+	0, 0, 1265, 0, 0, 597, 0, 0, 369, 596,
+	0, 0, 0, 0, 688, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1232, 0, 0,
+	0, 0, 595, 0, 0, 0, 0, 0, 0, 0,
+	0, 808, 583, 582, 592, 593, 585, 586, 587, 588,
+	589, 590, 591, 584, 0, 0, 0, 0, 0, 594,
+	0, 0, 0, 0, 0, 0, 597, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1271, 0, 0,
+	0, 595, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 1217, 1218, 0, 1317, 0, 0, 291, 0, 0,
+	0, 0, 1320, 1321, 1322, 0, 1225, 1226, 0, 1227,
+	1228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1235, 1236, 1336, 1337, 1338, 1339, 0, 0, 369,
+	0, 0, 0, 0, 0, 0, 369, 0, 898, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1360,
+	0, 0, 369, 925, 0, 0, 369, 369, 369, 0,
+	369, 369, 0, 595, 0, 0, 0, 369, 369, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	621, 1287, 0, 1381, 0, 0, 0, 1343, 0, 0,
+	0, 0, 1346, 803, 1347, 0, 0, 0, 1392, 0,
+	1352, 1352, 0, 568, 0, 1323, 369, 0, 0, 0,
+	0, 0, 0, 0, 595, 0, 991, 0, 0, 0,
+	0, 0, 0, 0, 0, 1013, 1014, 0, 1017, 1018,
+	0, 0, 1019, 858, 0, 0, 0, 0, 0, 0,
+	0, 0, 1318, 0, 0, 0, 0, 0, 1021, 0,
+	0, 860, 0, 1027, 1436, 0, 0, 1439, 1440, 1441,
+	1442, 596, 0, 0, 1446, 1447, 0, 0, 0, 0,
+	886, 0, 0, 0, 0, 0, 0, 0, 1454, 1455,
+	1456, 0, 0, 0, 0, 0, 0, 890, 891, 0,
+	0, 0, 0, 0, 583, 582, 592, 593, 585, 586,
+	587, 588, 589, 590, 591, 584, 0, 0, 0, 0,
+	0, 594, 0, 1485, 0, 369, 0, 0, 597, 0,
+	1490, 0, 1491, 1492, 0, 0, 0, 0, 369, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1496,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1387,
+	1388, 1389, 1390, 1391, 0, 0, 0, 1394, 1395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1477, 1480, 0, 0, 621, 0, 0, 0, 0,
+	0, 0, 0, 369, 0, 369, 0, 0, 0, 1011,
+	1012, 0, 0, 1533, 1534, 0, 0, 0, 0, 369,
+	0, 0, 0, 0, 0, 1543, 0, 1545, 0, 716,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 369, 0, 0, 1565, 1566, 0,
+	0, 0, 1035, 1036, 0, 0, 0, 0, 0, 543,
+	0, 1521, 1480, 621, 621, 0, 0, 0, 0, 596,
+	0, 0, 0, 0, 0, 0, 1531, 1532, 0, 0,
+	1216, 0, 1538, 66, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 621, 0, 595, 228, 0, 596,
+	254, 1538, 583, 582, 592, 593, 585, 586, 587, 588,
+	589, 590, 591, 584, 0, 704, 0, 0, 0, 594,
+	0, 0, 0, 66, 0, 0, 597, 0, 0, 1209,
+	0, 0, 583, 582, 592, 593, 585, 586, 587, 588,
+	589, 590, 591, 584, 0, 0, 0, 0, 0, 594,
+	0, 0, 0, 0, 717, 886, 597, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1120, 1120, 0, 0, 730, 733, 734, 735,
+	736, 737, 738, 0, 739, 740, 741, 742, 743, 718,
+	719, 720, 721, 702, 703, 731, 1557, 705, 369, 706,
+	707, 708, 709, 710, 711, 712, 713, 714, 715, 722,
+	723, 724, 725, 726, 727, 728, 729, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1173, 369, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 290, 596, 0,
+	360, 0, 1299, 0, 0, 228, 0, 228, 0, 1056,
+	0, 0, 732, 0, 0, 369, 0, 228, 0, 1302,
+	228, 0, 0, 0, 595, 0, 228, 0, 0, 228,
+	1311, 583, 582, 592, 593, 585, 586, 587, 588, 589,
+	590, 591, 584, 0, 369, 0, 0, 0, 594, 0,
+	230, 0, 0, 0, 595, 597, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 241, 0, 236, 66,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 369,
+	0, 0, 0, 0, 0, 0, 0, 0, 886, 0,
+	0, 552, 1247, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 369, 0,
+	369, 1280, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 228, 228, 228, 0, 0, 243, 233,
+	234, 0, 244, 245, 246, 248, 0, 247, 253, 0,
+	0, 0, 235, 238, 0, 231, 252, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1304, 0, 0, 1309, 1310, 0, 0, 0, 0,
+	0, 0, 369, 0, 1314, 0, 0, 0, 0, 0,
+	0, 0, 0, 595, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 886, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1120, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 369, 0, 0, 0, 0, 0, 228, 0,
+	1366, 0, 0, 0, 0, 0, 0, 0, 228, 228,
+	0, 0, 0, 1494, 228, 369, 0, 228, 0, 0,
+	228, 0, 369, 0, 767, 0, 0, 0, 0, 0,
+	0, 0, 0, 228, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1401, 0, 0, 0,
+	0, 0, 0, 0, 1366, 0, 0, 0, 0, 0,
+	0, 0, 0, 1366, 1366, 1366, 0, 0, 0, 1280,
+	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
+	767, 0, 0, 0, 0, 0, 1366, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 886, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1464, 0, 0, 290, 0,
+	0, 0, 0, 290, 290, 290, 369, 369, 290, 290,
+	290, 0, 0, 0, 887, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 886, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 290, 290, 290, 0, 228, 0,
+	0, 0, 0, 0, 0, 0, 228, 1498, 0, 66,
+	0, 0, 228, 228, 0, 0, 228, 928, 767, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1366, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 228, 0, 0, 0,
+	0, 0, 0, 0, 0, 228, 228, 0, 228, 228,
+	0, 0, 228, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
+	1025, 1026, 0, 228, 0, 0, 0, 0, 767, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 290, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 290, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 887,
+	228, 0, 228, 228, 228, 0, 0, 0, 0, 0,
+	0, 0, 1115, 0, 0, 228, 0, 0, 0, 0,
+	0, 66, 0, 228, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 290,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 767, 0, 0, 0, 0, 0, 0,
+	0, 0, 887, 0, 0, 0, 0, 0, 0, 0,
+	0, 228, 0, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 0, 152, 0, 0, 567, 0, 0,
+	0, 0, 94, 0, 0, 0, 0, 0, 112, 0,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 569, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	564, 563, 228, 0, 0, 0, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 565, 228,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 887,
+	89, 0, 146, 134, 169, 0, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 0, 1404,
+	0, 0, 0, 0, 0, 0, 1407, 0, 0, 0,
+	66, 0, 0, 0, 0, 0, 0, 71, 78, 113,
+	0, 141, 98, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 228, 887, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 887, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	404, 420, 452, 228, 419, 467, 396, 410, 475, 411,
+	413, 442, 381, 428, 132, 408, 186, 92, 88, 70,
+	444, 445, 450, 387, 412, 152, 0, 399, 376, 405,
+	377, 397, 422, 94, 425, 395, 454, 431, 466, 112,
+	473, 114, 436, 0, 154, 123, 0, 0, 424, 456,
+	0, 426, 449, 418, 443, 386, 435, 468, 409, 440,
+	469, 0, 0, 0, 214, 0, 945, 946, 0, 0,
+	0, 0, 0, 85, 0, 0, 0, 438, 463, 407,
+	439, 441, 375, 437, 0, 379, 382, 474, 458, 402,
+	96, 131, 1144, 0, 0, 0, 0, 0, 0, 423,
+	427, 446, 416, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 400, 0, 434, 0, 0, 0, 0, 0,
+	0, 383, 380, 0, 0, 421, 0, 0, 0, 0,
+	385, 0, 401, 447, 0, 374, 101, 451, 457, 0,
+	417, 176, 461, 415, 414, 465, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 455, 398,
+	406, 89, 403, 146, 134, 169, 433, 135, 145, 115,
+	162, 140, 462, 177, 178, 159, 175, 185, 73, 158,
+	168, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 81, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 378, 0, 155, 171, 189,
+	83, 394, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 127, 82, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 390,
+	393, 388, 389, 429, 430, 470, 471, 472, 448, 384,
+	0, 391, 392, 0, 453, 459, 460, 432, 71, 78,
+	113, 476, 141, 98, 172, 464, 404, 420, 452, 0,
+	419, 467, 396, 410, 475, 411, 413, 442, 381, 428,
+	132, 408, 186, 92, 88, 70, 444, 445, 450, 387,
+	412, 152, 0, 399, 376, 405, 377, 397, 422, 94,
+	425, 395, 454, 431, 466, 112, 473, 114, 436, 0,
+	154, 123, 0, 0, 424, 456, 0, 426, 449, 418,
+	443, 386, 435, 468, 409, 440, 469, 0, 0, 0,
+	214, 0, 945, 946, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 438, 463, 407, 439, 441, 375, 437,
+	0, 379, 382, 474, 458, 402, 96, 131, 0, 0,
+	0, 0, 0, 0, 0, 423, 427, 446, 416, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 400, 0,
+	434, 0, 0, 0, 0, 0, 0, 383, 380, 0,
+	0, 421, 0, 0, 0, 0, 385, 0, 401, 447,
+	0, 374, 101, 451, 457, 0, 417, 176, 461, 415,
+	414, 465, 139, 0, 157, 103, 111, 72, 79, 0,
+	102, 129, 144, 148, 455, 398, 406, 89, 403, 146,
+	134, 169, 433, 135, 145, 115, 162, 140, 462, 177,
+	178, 159, 175, 185, 73, 158, 168, 86, 149, 75,
+	166, 156, 121, 107, 108, 74, 0, 143, 93, 99,
+	91, 130, 163, 164, 90, 188, 80, 174, 77, 81,
+	173, 128, 161, 167, 122, 119, 76, 165, 120, 118,
+	110, 97, 104, 137, 117, 138, 105, 125, 124, 126,
+	0, 378, 0, 155, 171, 189, 83, 394, 150, 160,
+	179, 180, 181, 182, 183, 184, 0, 0, 84, 100,
+	95, 136, 127, 82, 106, 151, 109, 116, 142, 187,
+	133, 147, 87, 170, 153, 390, 393, 388, 389, 429,
+	430, 470, 471, 472, 448, 384, 0, 391, 392, 0,
+	453, 459, 460, 432, 71, 78, 113, 476, 141, 98,
+	172, 464, 404, 420, 452, 0, 419, 467, 396, 410,
+	475, 411, 413, 442, 381, 428, 132, 408, 186, 92,
+	88, 70, 444, 445, 450, 387, 412, 152, 0, 399,
+	376, 405, 377, 397, 422, 94, 425, 395, 454, 431,
+	466, 112, 473, 114, 436, 0, 154, 123, 0, 0,
+	424, 456, 0, 426, 449, 418, 443, 386, 435, 468,
+	409, 440, 469, 57, 0, 0, 214, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 438,
+	463, 407, 439, 441, 375, 437, 0, 379, 382, 474,
+	458, 402, 96, 131, 0, 0, 0, 0, 0, 0,
+	0, 423, 427, 446, 416, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 400, 0, 434, 0, 0, 0,
+	0, 0, 0, 383, 380, 0, 0, 421, 0, 0,
+	0, 0, 385, 0, 401, 447, 0, 374, 101, 451,
+	457, 0, 417, 176, 461, 415, 414, 465, 139, 0,
+	157, 103, 111, 72, 79, 0, 102, 129, 144, 148,
+	455, 398, 406, 89, 403, 146, 134, 169, 433, 135,
+	145, 115, 162, 140, 462, 177, 178, 159, 175, 185,
+	73, 158, 168, 86, 149, 75, 166, 156, 121, 107,
+	108, 74, 0, 143, 93, 99, 91, 130, 163, 164,
+	90, 188, 80, 174, 77, 81, 173, 128, 161, 167,
+	122, 119, 76, 165, 120, 118, 110, 97, 104, 137,
+	117, 138, 105, 125, 124, 126, 0, 378, 0, 155,
+	171, 189, 83, 394, 150, 160, 179, 180, 181, 182,
+	183, 184, 0, 0, 84, 100, 95, 136, 127, 82,
+	106, 151, 109, 116, 142, 187, 133, 147, 87, 170,
+	153, 390, 393, 388, 389, 429, 430, 470, 471, 472,
+	448, 384, 0, 391, 392, 0, 453, 459, 460, 432,
+	71, 78, 113, 476, 141, 98, 172, 464, 404, 420,
+	452, 0, 419, 467, 396, 410, 475, 411, 413, 442,
+	381, 428, 132, 408, 186, 92, 88, 70, 444, 445,
+	450, 387, 412, 152, 0, 399, 376, 405, 377, 397,
+	422, 94, 425, 395, 454, 431, 466, 112, 473, 114,
+	436, 0, 154, 123, 0, 0, 424, 456, 0, 426,
+	449, 418, 443, 386, 435, 468, 409, 440, 469, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	0, 85, 0, 0, 0, 438, 463, 407, 439, 441,
+	375, 437, 0, 379, 382, 474, 458, 402, 96, 131,
+	0, 0, 0, 0, 0, 0, 0, 423, 427, 446,
+	416, 0, 0, 0, 0, 0, 0, 0, 1212, 0,
+	400, 0, 434, 0, 0, 0, 0, 0, 0, 383,
+	380, 0, 0, 421, 0, 0, 0, 0, 385, 0,
+	401, 447, 0, 374, 101, 451, 457, 0, 417, 176,
+	461, 415, 414, 465, 139, 0, 157, 103, 111, 72,
+	79, 0, 102, 129, 144, 148, 455, 398, 406, 89,
+	403, 146, 134, 169, 433, 135, 145, 115, 162, 140,
+	462, 177, 178, 159, 175, 185, 73, 158, 168, 86,
+	149, 75, 166, 156, 121, 107, 108, 74, 0, 143,
+	93, 99, 91, 130, 163, 164, 90, 188, 80, 174,
+	77, 81, 173, 128, 161, 167, 122, 119, 76, 165,
+	120, 118, 110, 97, 104, 137, 117, 138, 105, 125,
+	124, 126, 0, 378, 0, 155, 171, 189, 83, 394,
+	150, 160, 179, 180, 181, 182, 183, 184, 0, 0,
+	84, 100, 95, 136, 127, 82, 106, 151, 109, 116,
+	142, 187, 133, 147, 87, 170, 153, 390, 393, 388,
+	389, 429, 430, 470, 471, 472, 448, 384, 0, 391,
+	392, 0, 453, 459, 460, 432, 71, 78, 113, 476,
+	141, 98, 172, 464, 404, 420, 452, 0, 419, 467,
+	396, 410, 475, 411, 413, 442, 381, 428, 132, 408,
+	186, 92, 88, 70, 444, 445, 450, 387, 412, 152,
+	0, 399, 376, 405, 377, 397, 422, 94, 425, 395,
+	454, 431, 466, 112, 473, 114, 436, 0, 154, 123,
+	0, 0, 424, 456, 0, 426, 449, 418, 443, 386,
+	435, 468, 409, 440, 469, 0, 0, 0, 68, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 438, 463, 407, 439, 441, 375, 437, 0, 379,
+	382, 474, 458, 402, 96, 131, 0, 0, 0, 0,
+	0, 0, 0, 423, 427, 446, 416, 0, 0, 0,
+	0, 0, 0, 0, 929, 0, 400, 0, 434, 0,
+	0, 0, 0, 0, 0, 383, 380, 0, 0, 421,
+	0, 0, 0, 0, 385, 0, 401, 447, 0, 374,
+	101, 451, 457, 0, 417, 176, 461, 415, 414, 465,
+	139, 0, 157, 103, 111, 72, 79, 0, 102, 129,
+	144, 148, 455, 398, 406, 89, 403, 146, 134, 169,
+	433, 135, 145, 115, 162, 140, 462, 177, 178, 159,
+	175, 185, 73, 158, 168, 86, 149, 75, 166, 156,
+	121, 107, 108, 74, 0, 143, 93, 99, 91, 130,
+	163, 164, 90, 188, 80, 174, 77, 81, 173, 128,
+	161, 167, 122, 119, 76, 165, 120, 118, 110, 97,
+	104, 137, 117, 138, 105, 125, 124, 126, 0, 378,
+	0, 155, 171, 189, 83, 394, 150, 160, 179, 180,
+	181, 182, 183, 184, 0, 0, 84, 100, 95, 136,
+	127, 82, 106, 151, 109, 116, 142, 187, 133, 147,
+	87, 170, 153, 390, 393, 388, 389, 429, 430, 470,
+	471, 472, 448, 384, 0, 391, 392, 0, 453, 459,
+	460, 432, 71, 78, 113, 476, 141, 98, 172, 464,
+	404, 420, 452, 0, 419, 467, 396, 410, 475, 411,
+	413, 442, 381, 428, 132, 408, 186, 92, 88, 70,
+	444, 445, 450, 387, 412, 152, 0, 399, 376, 405,
+	377, 397, 422, 94, 425, 395, 454, 431, 466, 112,
+	473, 114, 436, 0, 154, 123, 0, 0, 424, 456,
+	0, 426, 449, 418, 443, 386, 435, 468, 409, 440,
+	469, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 0, 438, 463, 407,
+	439, 441, 375, 437, 0, 379, 382, 474, 458, 402,
+	96, 131, 0, 0, 0, 0, 0, 0, 0, 423,
+	427, 446, 416, 0, 0, 0, 0, 0, 0, 0,
+	817, 0, 400, 0, 434, 0, 0, 0, 0, 0,
+	0, 383, 380, 0, 0, 421, 0, 0, 0, 0,
+	385, 0, 401, 447, 0, 374, 101, 451, 457, 0,
+	417, 176, 461, 415, 414, 465, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 455, 398,
+	406, 89, 403, 146, 134, 169, 433, 135, 145, 115,
+	162, 140, 462, 177, 178, 159, 175, 185, 73, 158,
+	168, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 81, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 378, 0, 155, 171, 189,
+	83, 394, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 127, 82, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 390,
+	393, 388, 389, 429, 430, 470, 471, 472, 448, 384,
+	0, 391, 392, 0, 453, 459, 460, 432, 71, 78,
+	113, 476, 141, 98, 172, 464, 404, 420, 452, 0,
+	419, 467, 396, 410, 475, 411, 413, 442, 381, 428,
+	132, 408, 186, 92, 88, 70, 444, 445, 450, 387,
+	412, 152, 0, 399, 376, 405, 377, 397, 422, 94,
+	425, 395, 454, 431, 466, 112, 473, 114, 436, 0,
+	154, 123, 0, 0, 424, 456, 0, 426, 449, 418,
+	443, 386, 435, 468, 409, 440, 469, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 438, 463, 407, 439, 441, 375, 437,
+	0, 379, 382, 474, 458, 402, 96, 131, 0, 0,
+	0, 0, 0, 0, 0, 423, 427, 446, 416, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 400, 0,
+	434, 0, 0, 0, 0, 0, 0, 383, 380, 0,
+	0, 421, 0, 0, 0, 0, 385, 0, 401, 447,
+	0, 374, 101, 451, 457, 0, 417, 176, 461, 415,
+	414, 465, 139, 0, 157, 103, 111, 72, 79, 0,
+	102, 129, 144, 148, 455, 398, 406, 89, 403, 146,
+	134, 169, 433, 135, 145, 115, 162, 140, 462, 177,
+	178, 159, 175, 185, 73, 158, 168, 86, 149, 75,
+	166, 156, 121, 107, 108, 74, 0, 143, 93, 99,
+	91, 130, 163, 164, 90, 188, 80, 174, 77, 81,
+	173, 128, 161, 167, 122, 119, 76, 165, 120, 118,
+	110, 97, 104, 137, 117, 138, 105, 125, 124, 126,
+	0, 378, 0, 155, 171, 189, 83, 394, 150, 160,
+	179, 180, 181, 182, 183, 184, 0, 0, 84, 100,
+	95, 136, 127, 82, 106, 151, 109, 116, 142, 187,
+	133, 147, 87, 170, 153, 390, 393, 388, 389, 429,
+	430, 470, 471, 472, 448, 384, 0, 391, 392, 0,
+	453, 459, 460, 432, 71, 78, 113, 476, 141, 98,
+	172, 464, 404, 420, 452, 0, 419, 467, 396, 410,
+	475, 411, 413, 442, 381, 428, 132, 408, 186, 92,
+	88, 70, 444, 445, 450, 387, 412, 152, 0, 399,
+	376, 405, 377, 397, 422, 94, 425, 395, 454, 431,
+	466, 112, 473, 114, 436, 0, 154, 123, 0, 0,
+	424, 456, 0, 426, 449, 418, 443, 386, 435, 468,
+	409, 440, 469, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 438,
+	463, 407, 439, 441, 375, 437, 0, 379, 382, 474,
+	458, 402, 96, 131, 0, 0, 0, 0, 0, 0,
+	0, 423, 427, 446, 416, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 400, 0, 434, 0, 0, 0,
+	0, 0, 0, 383, 380, 0, 0, 421, 0, 0,
+	0, 0, 385, 0, 401, 447, 0, 374, 101, 451,
+	457, 0, 417, 176, 461, 415, 414, 465, 139, 0,
+	157, 103, 111, 72, 79, 0, 102, 129, 144, 148,
+	455, 398, 406, 89, 403, 146, 134, 169, 433, 135,
+	145, 115, 162, 140, 462, 177, 178, 159, 175, 185,
+	73, 158, 168, 86, 149, 75, 166, 156, 121, 107,
+	108, 74, 0, 143, 93, 99, 91, 130, 163, 164,
+	90, 188, 80, 174, 77, 81, 173, 128, 161, 167,
+	122, 119, 76, 165, 120, 118, 110, 97, 104, 137,
+	117, 138, 105, 125, 124, 126, 0, 378, 0, 155,
+	171, 189, 83, 394, 150, 160, 179, 180, 181, 182,
+	183, 184, 0, 0, 84, 100, 95, 136, 127, 82,
+	106, 151, 109, 116, 142, 187, 133, 147, 87, 170,
+	153, 390, 393, 388, 389, 429, 430, 470, 471, 472,
+	448, 384, 0, 391, 392, 0, 453, 459, 460, 432,
+	71, 78, 113, 476, 141, 98, 172, 464, 404, 420,
+	452, 0, 419, 467, 396, 410, 475, 411, 413, 442,
+	381, 428, 132, 408, 186, 92, 88, 70, 444, 445,
+	450, 387, 412, 152, 0, 399, 376, 405, 377, 397,
+	422, 94, 425, 395, 454, 431, 466, 112, 473, 114,
+	436, 0, 154, 123, 0, 0, 424, 456, 0, 426,
+	449, 418, 443, 386, 435, 468, 409, 440, 469, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	0, 85, 0, 0, 0, 438, 463, 407, 439, 441,
+	375, 437, 0, 379, 382, 474, 458, 402, 96, 131,
+	0, 0, 0, 0, 0, 0, 0, 423, 427, 446,
+	416, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	400, 0, 434, 0, 0, 0, 0, 0, 0, 383,
+	380, 0, 0, 421, 0, 0, 0, 0, 385, 0,
+	401, 447, 0, 374, 101, 451, 457, 0, 417, 176,
+	461, 415, 414, 465, 139, 0, 157, 103, 111, 72,
+	79, 0, 102, 129, 144, 148, 455, 398, 406, 89,
+	403, 146, 134, 169, 433, 135, 145, 115, 162, 140,
+	462, 177, 178, 159, 175, 185, 73, 158, 168, 86,
+	149, 75, 166, 156, 121, 107, 108, 74, 0, 143,
+	93, 99, 91, 130, 163, 164, 90, 188, 80, 174,
+	77, 372, 173, 128, 161, 167, 122, 119, 76, 165,
+	120, 118, 110, 97, 104, 137, 117, 138, 105, 125,
+	124, 126, 0, 378, 0, 155, 171, 189, 83, 394,
+	150, 160, 179, 180, 181, 182, 183, 184, 0, 0,
+	84, 100, 95, 136, 373, 371, 106, 151, 109, 116,
+	142, 187, 133, 147, 87, 170, 153, 390, 393, 388,
+	389, 429, 430, 470, 471, 472, 448, 384, 0, 391,
+	392, 0, 453, 459, 460, 432, 71, 78, 113, 476,
+	141, 98, 172, 464, 404, 420, 452, 0, 419, 467,
+	396, 410, 475, 411, 413, 442, 381, 428, 132, 408,
+	186, 92, 88, 70, 444, 445, 450, 387, 412, 152,
+	0, 399, 376, 405, 377, 397, 422, 94, 425, 395,
+	454, 431, 466, 112, 473, 114, 436, 0, 154, 123,
+	0, 0, 424, 456, 0, 426, 449, 418, 443, 386,
+	435, 468, 409, 440, 469, 0, 0, 0, 68, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 438, 463, 407, 439, 441, 375, 437, 0, 379,
+	382, 474, 458, 402, 96, 131, 0, 0, 0, 0,
+	0, 0, 0, 423, 427, 446, 416, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 400, 0, 434, 0,
+	0, 0, 0, 0, 0, 383, 380, 0, 0, 421,
+	0, 0, 0, 0, 385, 0, 401, 447, 0, 374,
+	101, 451, 457, 0, 417, 176, 461, 415, 414, 465,
+	139, 0, 157, 103, 111, 72, 79, 0, 102, 129,
+	144, 148, 455, 398, 406, 89, 403, 146, 134, 169,
+	433, 135, 145, 115, 162, 140, 462, 177, 178, 159,
+	175, 185, 73, 158, 168, 86, 149, 75, 166, 156,
+	121, 107, 108, 74, 0, 143, 93, 99, 91, 130,
+	163, 164, 90, 188, 80, 174, 77, 81, 173, 128,
+	161, 167, 122, 119, 76, 165, 120, 118, 110, 97,
+	104, 137, 117, 138, 105, 125, 124, 126, 0, 378,
+	0, 155, 171, 189, 83, 394, 150, 160, 179, 180,
+	181, 182, 183, 184, 0, 0, 84, 100, 95, 136,
+	127, 82, 106, 151, 109, 116, 142, 187, 133, 147,
+	87, 170, 153, 390, 393, 388, 389, 429, 430, 470,
+	471, 472, 448, 384, 0, 391, 392, 0, 453, 459,
+	460, 432, 71, 78, 113, 476, 141, 98, 172, 464,
+	404, 420, 452, 0, 419, 467, 396, 410, 475, 411,
+	413, 442, 381, 428, 132, 408, 186, 92, 88, 70,
+	444, 445, 450, 387, 412, 152, 0, 399, 376, 405,
+	377, 397, 422, 94, 425, 395, 454, 431, 466, 112,
+	473, 114, 436, 0, 154, 123, 0, 0, 424, 456,
+	0, 426, 449, 418, 443, 386, 435, 468, 409, 440,
+	469, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 0, 438, 463, 407,
+	439, 441, 375, 437, 0, 379, 382, 474, 458, 402,
+	96, 131, 0, 0, 0, 0, 0, 0, 0, 423,
+	427, 446, 416, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 400, 0, 434, 0, 0, 0, 0, 0,
+	0, 383, 380, 0, 0, 421, 0, 0, 0, 0,
+	385, 0, 401, 447, 0, 374, 101, 451, 457, 0,
+	417, 176, 461, 415, 414, 465, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 455, 398,
+	406, 89, 403, 146, 134, 169, 433, 135, 145, 115,
+	162, 140, 462, 177, 178, 159, 175, 185, 73, 158,
+	681, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 372, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 378, 0, 155, 171, 189,
+	83, 394, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 373, 371, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 390,
+	393, 388, 389, 429, 430, 470, 471, 472, 448, 384,
+	0, 391, 392, 0, 453, 459, 460, 432, 71, 78,
+	113, 476, 141, 98, 172, 464, 404, 420, 452, 0,
+	419, 467, 396, 410, 475, 411, 413, 442, 381, 428,
+	132, 408, 186, 92, 88, 70, 444, 445, 450, 387,
+	412, 152, 0, 399, 376, 405, 377, 397, 422, 94,
+	425, 395, 454, 431, 466, 112, 473, 114, 436, 0,
+	154, 123, 0, 0, 424, 456, 0, 426, 449, 418,
+	443, 386, 435, 468, 409, 440, 469, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 438, 463, 407, 439, 441, 375, 437,
+	0, 379, 382, 474, 458, 402, 96, 131, 0, 0,
+	0, 0, 0, 0, 0, 423, 427, 446, 416, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 400, 0,
+	434, 0, 0, 0, 0, 0, 0, 383, 380, 0,
+	0, 421, 0, 0, 0, 0, 385, 0, 401, 447,
+	0, 374, 101, 451, 457, 0, 417, 176, 461, 415,
+	414, 465, 139, 0, 157, 103, 111, 72, 79, 0,
+	102, 129, 144, 148, 455, 398, 406, 89, 403, 146,
+	134, 169, 433, 135, 145, 115, 162, 140, 462, 177,
+	178, 159, 175, 185, 73, 158, 363, 86, 149, 75,
+	166, 156, 121, 107, 108, 74, 0, 143, 93, 99,
+	91, 130, 163, 164, 90, 188, 80, 174, 77, 372,
+	173, 128, 161, 167, 122, 119, 76, 165, 120, 118,
+	110, 97, 104, 137, 117, 138, 105, 125, 124, 126,
+	0, 378, 0, 155, 171, 189, 83, 394, 150, 160,
+	179, 180, 181, 182, 183, 184, 0, 0, 84, 100,
+	95, 136, 373, 371, 366, 365, 109, 116, 142, 187,
+	133, 147, 87, 170, 153, 390, 393, 388, 389, 429,
+	430, 470, 471, 472, 448, 384, 0, 391, 392, 26,
+	453, 459, 460, 432, 71, 78, 113, 476, 141, 98,
+	172, 132, 0, 186, 92, 88, 70, 0, 0, 0,
+	0, 330, 152, 0, 0, 0, 314, 0, 0, 0,
+	94, 0, 294, 0, 0, 0, 112, 338, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 328, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	537, 295, 316, 315, 318, 319, 320, 321, 0, 0,
+	85, 317, 0, 0, 322, 323, 324, 0, 0, 0,
+	293, 308, 0, 337, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 305, 306, 0, 0, 0,
+	0, 351, 0, 307, 0, 0, 0, 0, 0, 302,
+	303, 304, 309, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 349, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 339, 350, 345, 346,
+	343, 344, 342, 341, 340, 352, 331, 332, 333, 334,
+	336, 0, 347, 348, 335, 71, 78, 113, 23, 141,
+	98, 172, 132, 0, 186, 92, 88, 70, 0, 0,
+	1481, 1482, 1483, 152, 0, 0, 0, 314, 0, 0,
+	0, 94, 0, 294, 0, 0, 0, 112, 338, 114,
+	0, 0, 154, 123, 0, 0, 0, 0, 0, 328,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	0, 0, 295, 316, 315, 318, 319, 320, 321, 0,
+	0, 85, 317, 0, 0, 322, 323, 324, 0, 0,
+	0, 293, 308, 0, 337, 0, 0, 0, 96, 131,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 305, 306, 0, 0,
+	0, 0, 351, 0, 307, 0, 0, 0, 0, 0,
+	302, 303, 304, 309, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 176,
+	0, 0, 349, 0, 139, 0, 157, 103, 111, 72,
+	79, 0, 102, 129, 144, 148, 0, 0, 0, 89,
+	0, 146, 134, 169, 0, 135, 145, 115, 162, 140,
+	0, 177, 178, 159, 175, 185, 73, 158, 168, 86,
+	149, 75, 166, 156, 121, 107, 108, 74, 0, 143,
+	93, 99, 91, 130, 163, 164, 90, 188, 80, 174,
+	77, 81, 173, 128, 161, 167, 122, 119, 76, 165,
+	120, 118, 110, 97, 104, 137, 117, 138, 105, 125,
+	124, 126, 0, 0, 0, 155, 171, 189, 83, 0,
+	150, 160, 179, 180, 181, 182, 183, 184, 0, 0,
+	84, 100, 95, 136, 127, 82, 106, 151, 109, 116,
+	142, 187, 133, 147, 87, 170, 153, 339, 350, 345,
+	346, 343, 344, 342, 341, 340, 352, 331, 332, 333,
+	334, 336, 0, 347, 348, 335, 71, 78, 113, 0,
+	141, 98, 172, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 330, 152, 0, 0, 0, 314, 0,
+	0, 0, 94, 0, 294, 0, 0, 0, 112, 338,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	328, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 295, 316, 315, 318, 319, 320, 321,
+	0, 0, 85, 317, 0, 0, 322, 323, 324, 0,
+	0, 0, 293, 308, 0, 337, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 305, 306, 0,
+	0, 0, 0, 351, 0, 307, 0, 0, 0, 0,
+	0, 302, 303, 304, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 1353, 1354, 0,
+	176, 0, 0, 349, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 0, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 339, 350,
+	345, 346, 343, 344, 342, 341, 340, 352, 331, 332,
+	333, 334, 336, 0, 347, 348, 335, 71, 78, 113,
+	0, 141, 98, 172, 132, 0, 186, 92, 88, 70,
+	0, 0, 0, 0, 330, 152, 0, 0, 0, 314,
+	0, 0, 0, 94, 0, 294, 0, 0, 0, 112,
+	338, 114, 0, 0, 154, 123, 0, 0, 0, 0,
+	0, 328, 329, 0, 0, 0, 0, 0, 0, 936,
+	0, 57, 0, 0, 295, 316, 315, 318, 319, 320,
+	321, 0, 0, 85, 317, 0, 0, 322, 323, 324,
+	937, 0, 0, 293, 308, 0, 337, 0, 0, 0,
+	96, 131, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 305, 306,
+	0, 0, 0, 0, 351, 0, 307, 0, 0, 0,
+	0, 0, 302, 303, 304, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 176, 0, 0, 349, 0, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 0, 0,
+	0, 89, 0, 146, 134, 169, 0, 135, 145, 115,
+	162, 140, 0, 177, 178, 159, 175, 185, 73, 158,
+	168, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 81, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 0, 0, 155, 171, 189,
+	83, 0, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 127, 82, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 339,
+	350, 345, 346, 343, 344, 342, 341, 340, 352, 331,
+	332, 333, 334, 336, 26, 347, 348, 335, 71, 78,
+	113, 0, 141, 98, 172, 0, 132, 0, 186, 92,
+	88, 70, 0, 0, 0, 0, 330, 152, 0, 0,
+	0, 314, 0, 0, 0, 94, 0, 294, 0, 0,
+	0, 112, 338, 114, 0, 0, 154, 123, 0, 0,
+	0, 0, 0, 328, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 0, 0, 295, 316, 315, 318,
+	319, 320, 321, 0, 0, 85, 317, 0, 0, 322,
+	323, 324, 0, 0, 0, 293, 308, 0, 337, 0,
+	0, 0, 96, 131, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	305, 306, 0, 0, 0, 0, 351, 0, 307, 0,
+	0, 0, 0, 0, 302, 303, 304, 309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 176, 0, 0, 349, 0, 139, 0,
+	157, 103, 111, 72, 79, 0, 102, 129, 144, 148,
+	0, 0, 0, 89, 0, 146, 134, 169, 0, 135,
+	145, 115, 162, 140, 0, 177, 178, 159, 175, 185,
+	73, 158, 168, 86, 149, 75, 166, 156, 121, 107,
+	108, 74, 0, 143, 93, 99, 91, 130, 163, 164,
+	90, 188, 80, 174, 77, 81, 173, 128, 161, 167,
+	122, 119, 76, 165, 120, 118, 110, 97, 104, 137,
+	117, 138, 105, 125, 124, 126, 0, 0, 0, 155,
+	171, 189, 83, 0, 150, 160, 179, 180, 181, 182,
+	183, 184, 0, 0, 84, 100, 95, 136, 127, 82,
+	106, 151, 109, 116, 142, 187, 133, 147, 87, 170,
+	153, 339, 350, 345, 346, 343, 344, 342, 341, 340,
+	352, 331, 332, 333, 334, 336, 0, 347, 348, 335,
+	71, 78, 113, 23, 141, 98, 172, 132, 0, 186,
+	92, 88, 70, 0, 0, 0, 0, 330, 152, 0,
+	862, 0, 314, 0, 0, 0, 94, 0, 294, 0,
+	0, 0, 112, 338, 114, 0, 0, 154, 123, 0,
+	0, 0, 0, 0, 328, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 57, 0, 0, 295, 316, 315,
+	318, 319, 320, 321, 0, 0, 85, 317, 0, 0,
+	322, 323, 324, 0, 0, 0, 293, 308, 0, 337,
+	0, 0, 0, 96, 131, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 305, 306, 288, 0, 0, 0, 351, 0, 307,
+	0, 0, 0, 0, 0, 302, 303, 304, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 176, 0, 0, 349, 0, 139,
+	0, 157, 103, 111, 72, 79, 0, 102, 129, 144,
+	148, 0, 0, 0, 89, 0, 146, 134, 169, 0,
+	135, 145, 115, 162, 140, 0, 177, 178, 159, 175,
+	185, 73, 158, 168, 86, 149, 75, 166, 156, 121,
+	107, 108, 74, 0, 143, 93, 99, 91, 130, 163,
+	164, 90, 188, 80, 174, 77, 81, 173, 128, 161,
+	167, 122, 119, 76, 165, 120, 118, 110, 97, 104,
+	137, 117, 138, 105, 125, 124, 126, 0, 0, 0,
+	155, 171, 189, 83, 0, 150, 160, 179, 180, 181,
+	182, 183, 184, 0, 0, 84, 100, 95, 136, 127,
+	82, 106, 151, 109, 116, 142, 187, 133, 147, 87,
+	170, 153, 339, 350, 345, 346, 343, 344, 342, 341,
+	340, 352, 331, 332, 333, 334, 336, 0, 347, 348,
+	335, 71, 78, 113, 0, 141, 98, 172, 132, 0,
+	186, 92, 88, 70, 0, 0, 0, 0, 330, 152,
+	0, 0, 0, 314, 0, 0, 0, 94, 0, 294,
+	0, 0, 0, 112, 338, 114, 0, 0, 154, 123,
+	0, 0, 0, 0, 0, 328, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 0, 537, 295, 316,
+	315, 318, 319, 320, 321, 0, 0, 85, 317, 0,
+	0, 322, 323, 324, 0, 0, 0, 293, 308, 0,
+	337, 0, 0, 0, 96, 131, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 305, 306, 0, 0, 0, 0, 351, 0,
+	307, 0, 0, 0, 0, 0, 302, 303, 304, 309,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 176, 0, 0, 349, 0,
+	139, 0, 157, 103, 111, 72, 79, 0, 102, 129,
+	144, 148, 0, 0, 0, 89, 0, 146, 134, 169,
+	0, 135, 145, 115, 162, 140, 0, 177, 178, 159,
+	175, 185, 73, 158, 168, 86, 149, 75, 166, 156,
+	121, 107, 108, 74, 0, 143, 93, 99, 91, 130,
+	163, 164, 90, 188, 80, 174, 77, 81, 173, 128,
+	161, 167, 122, 119, 76, 165, 120, 118, 110, 97,
+	104, 137, 117, 138, 105, 125, 124, 126, 0, 0,
+	0, 155, 171, 189, 83, 0, 150, 160, 179, 180,
+	181, 182, 183, 184, 0, 0, 84, 100, 95, 136,
+	127, 82, 106, 151, 109, 116, 142, 187, 133, 147,
+	87, 170, 153, 339, 350, 345, 346, 343, 344, 342,
+	341, 340, 352, 331, 332, 333, 334, 336, 0, 347,
+	348, 335, 71, 78, 113, 0, 141, 98, 172, 132,
+	0, 186, 92, 88, 70, 0, 0, 0, 0, 330,
+	152, 0, 0, 0, 314, 0, 0, 0, 94, 0,
+	294, 0, 0, 0, 112, 338, 114, 0, 0, 154,
+	123, 0, 0, 0, 0, 0, 328, 329, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 295,
+	316, 315, 318, 319, 320, 321, 0, 0, 85, 317,
+	0, 0, 322, 323, 324, 0, 0, 0, 293, 308,
+	0, 337, 0, 0, 0, 96, 131, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 305, 306, 288, 0, 0, 0, 351,
+	0, 307, 0, 0, 0, 0, 0, 302, 303, 304,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 176, 0, 0, 349,
+	0, 139, 0, 157, 103, 111, 72, 79, 0, 102,
+	129, 144, 148, 0, 0, 0, 89, 0, 146, 134,
+	169, 0, 135, 145, 115, 162, 140, 0, 177, 178,
+	159, 175, 185, 73, 158, 168, 86, 149, 75, 166,
+	156, 121, 107, 108, 74, 0, 143, 93, 99, 91,
+	130, 163, 164, 90, 188, 80, 174, 77, 81, 173,
+	128, 161, 167, 122, 119, 76, 165, 120, 118, 110,
+	97, 104, 137, 117, 138, 105, 125, 124, 126, 0,
+	0, 0, 155, 171, 189, 83, 0, 150, 160, 179,
+	180, 181, 182, 183, 184, 0, 0, 84, 100, 95,
+	136, 127, 82, 106, 151, 109, 116, 142, 187, 133,
+	147, 87, 170, 153, 339, 350, 345, 346, 343, 344,
+	342, 341, 340, 352, 331, 332, 333, 334, 336, 0,
+	347, 348, 335, 71, 78, 113, 0, 141, 98, 172,
+	132, 0, 186, 92, 88, 70, 0, 0, 0, 0,
+	330, 152, 0, 0, 0, 314, 0, 0, 0, 94,
+	0, 294, 0, 0, 0, 112, 338, 114, 0, 0,
+	154, 123, 0, 0, 0, 0, 0, 328, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	295, 316, 878, 318, 319, 320, 321, 0, 0, 85,
+	317, 0, 0, 322, 323, 324, 0, 0, 0, 293,
+	308, 0, 337, 0, 0, 0, 96, 131, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 305, 306, 288, 0, 0, 0,
+	351, 0, 307, 0, 0, 0, 0, 0, 302, 303,
+	304, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 176, 0, 0,
+	349, 0, 139, 0, 157, 103, 111, 72, 79, 0,
+	102, 129, 144, 148, 0, 0, 0, 89, 0, 146,
+	134, 169, 0, 135, 145, 115, 162, 140, 0, 177,
+	178, 159, 175, 185, 73, 158, 168, 86, 149, 75,
+	166, 156, 121, 107, 108, 74, 0, 143, 93, 99,
+	91, 130, 163, 164, 90, 188, 80, 174, 77, 81,
+	173, 128, 161, 167, 122, 119, 76, 165, 120, 118,
+	110, 97, 104, 137, 117, 138, 105, 125, 124, 126,
+	0, 0, 0, 155, 171, 189, 83, 0, 150, 160,
+	179, 180, 181, 182, 183, 184, 0, 0, 84, 100,
+	95, 136, 127, 82, 106, 151, 109, 116, 142, 187,
+	133, 147, 87, 170, 153, 339, 350, 345, 346, 343,
+	344, 342, 341, 340, 352, 331, 332, 333, 334, 336,
+	0, 347, 348, 335, 71, 78, 113, 0, 141, 98,
+	172, 132, 0, 186, 92, 88, 70, 0, 0, 0,
+	0, 330, 152, 0, 0, 0, 314, 0, 0, 0,
+	94, 0, 294, 0, 0, 0, 112, 338, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 328, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 295, 316, 875, 318, 319, 320, 321, 0, 0,
+	85, 317, 0, 0, 322, 323, 324, 0, 0, 0,
+	293, 308, 0, 337, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 305, 306, 288, 0, 0,
+	0, 351, 0, 307, 0, 0, 0, 0, 0, 302,
+	303, 304, 309, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 349, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 339, 350, 345, 346,
+	343, 344, 342, 341, 340, 352, 331, 332, 333, 334,
+	336, 0, 347, 348, 335, 71, 78, 113, 0, 141,
+	98, 172, 132, 0, 186, 92, 88, 70, 0, 0,
+	0, 0, 330, 152, 0, 0, 0, 314, 0, 0,
+	0, 94, 0, 294, 0, 0, 0, 112, 338, 114,
+	0, 0, 154, 123, 0, 0, 0, 0, 0, 328,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	0, 0, 295, 316, 315, 318, 319, 320, 321, 0,
+	0, 85, 317, 0, 0, 322, 323, 324, 0, 0,
+	0, 293, 308, 0, 337, 0, 0, 0, 96, 131,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 305, 306, 0, 0,
+	0, 0, 351, 0, 307, 0, 0, 0, 0, 0,
+	302, 303, 304, 309, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 176,
+	0, 0, 349, 0, 139, 0, 157, 103, 111, 72,
+	79, 0, 102, 129, 144, 148, 0, 0, 0, 89,
+	0, 146, 134, 169, 0, 135, 145, 115, 162, 140,
+	0, 177, 178, 159, 175, 185, 73, 158, 168, 86,
+	149, 75, 166, 156, 121, 107, 108, 74, 0, 143,
+	93, 99, 91, 130, 163, 164, 90, 188, 80, 174,
+	77, 81, 173, 128, 161, 167, 122, 119, 76, 165,
+	120, 118, 110, 97, 104, 137, 117, 138, 105, 125,
+	124, 126, 0, 0, 0, 155, 171, 189, 83, 0,
+	150, 160, 179, 180, 181, 182, 183, 184, 0, 0,
+	84, 100, 95, 136, 127, 82, 106, 151, 109, 116,
+	142, 187, 133, 147, 87, 170, 153, 339, 350, 345,
+	346, 343, 344, 342, 341, 340, 352, 331, 332, 333,
+	334, 336, 0, 347, 348, 335, 71, 78, 113, 0,
+	141, 98, 172, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 330, 152, 0, 0, 0, 0, 0,
+	0, 0, 94, 0, 0, 0, 0, 0, 112, 338,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	328, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 295, 316, 315, 318, 319, 320, 321,
+	0, 0, 85, 317, 0, 0, 322, 323, 324, 0,
+	0, 0, 0, 308, 0, 337, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 305, 306, 0,
+	0, 0, 0, 351, 0, 307, 0, 0, 0, 0,
+	0, 302, 303, 304, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	176, 0, 0, 349, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 1558, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 339, 350,
+	345, 346, 343, 344, 342, 341, 340, 352, 331, 332,
+	333, 334, 336, 0, 347, 348, 335, 71, 78, 113,
+	0, 141, 98, 172, 132, 0, 186, 92, 88, 70,
+	0, 0, 0, 0, 330, 152, 0, 0, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 0, 0, 112,
+	338, 114, 0, 0, 154, 123, 0, 0, 0, 0,
+	0, 328, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 57, 0, 537, 295, 316, 315, 318, 319, 320,
+	321, 0, 0, 85, 317, 0, 0, 322, 323, 324,
+	0, 0, 0, 0, 308, 0, 337, 0, 0, 0,
+	96, 131, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 305, 306,
+	0, 0, 0, 0, 351, 0, 307, 0, 0, 0,
+	0, 0, 302, 303, 304, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 176, 0, 0, 349, 0, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 0, 0,
+	0, 89, 0, 146, 134, 169, 0, 135, 145, 115,
+	162, 140, 0, 177, 178, 159, 175, 185, 73, 158,
+	168, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 81, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 0, 0, 155, 171, 189,
+	83, 0, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 127, 82, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 339,
+	350, 345, 346, 343, 344, 342, 341, 340, 352, 331,
+	332, 333, 334, 336, 0, 347, 348, 335, 71, 78,
+	113, 0, 141, 98, 172, 132, 0, 186, 92, 88,
+	70, 0, 0, 0, 0, 330, 152, 0, 0, 0,
+	0, 0, 0, 0, 94, 0, 0, 0, 0, 0,
+	112, 338, 114, 0, 0, 154, 123, 0, 0, 0,
+	0, 0, 328, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 295, 316, 315, 318, 319,
+	320, 321, 0, 0, 85, 317, 0, 0, 322, 323,
+	324, 0, 0, 0, 0, 308, 0, 337, 0, 0,
+	0, 96, 131, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 305,
+	306, 0, 0, 0, 0, 351, 0, 307, 0, 0,
+	0, 0, 0, 302, 303, 304, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 176, 0, 0, 349, 0, 139, 0, 157,
+	103, 111, 72, 79, 0, 102, 129, 144, 148, 0,
+	0, 0, 89, 0, 146, 134, 169, 0, 135, 145,
+	115, 162, 140, 0, 177, 178, 159, 175, 185, 73,
+	158, 168, 86, 149, 75, 166, 156, 121, 107, 108,
+	74, 0, 143, 93, 99, 91, 130, 163, 164, 90,
+	188, 80, 174, 77, 81, 173, 128, 161, 167, 122,
+	119, 76, 165, 120, 118, 110, 97, 104, 137, 117,
+	138, 105, 125, 124, 126, 0, 0, 0, 155, 171,
+	189, 83, 0, 150, 160, 179, 180, 181, 182, 183,
+	184, 0, 0, 84, 100, 95, 136, 127, 82, 106,
+	151, 109, 116, 142, 187, 133, 147, 87, 170, 153,
+	339, 350, 345, 346, 343, 344, 342, 341, 340, 352,
+	331, 332, 333, 334, 336, 0, 347, 348, 335, 71,
+	78, 113, 0, 141, 98, 172, 132, 0, 186, 92,
+	88, 70, 0, 0, 0, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 0,
+	0, 112, 0, 114, 0, 0, 154, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 0, 0,
+	0, 0, 0, 596, 0, 85, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 131, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 583, 582, 592, 593,
+	585, 586, 587, 588, 589, 590, 591, 584, 0, 0,
+	0, 0, 0, 594, 0, 0, 0, 0, 0, 0,
+	597, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 176, 0, 0, 0, 0, 139, 0,
+	157, 103, 111, 72, 79, 0, 102, 129, 144, 148,
+	0, 0, 0, 89, 0, 146, 134, 169, 0, 135,
+	145, 115, 162, 140, 0, 177, 178, 159, 175, 185,
+	73, 158, 168, 86, 149, 75, 166, 156, 121, 107,
+	108, 74, 0, 143, 93, 99, 91, 130, 163, 164,
+	90, 188, 80, 174, 77, 81, 173, 128, 161, 167,
+	122, 119, 76, 165, 120, 118, 110, 97, 104, 137,
+	117, 138, 105, 125, 124, 126, 0, 0, 0, 155,
+	171, 189, 83, 0, 150, 160, 179, 180, 181, 182,
+	183, 184, 0, 0, 84, 100, 95, 136, 127, 82,
+	106, 151, 109, 116, 142, 187, 133, 147, 87, 170,
+	153, 0, 0, 0, 132, 0, 186, 92, 88, 70,
+	0, 0, 0, 0, 0, 152, 0, 0, 0, 0,
+	71, 78, 113, 94, 141, 98, 172, 0, 595, 112,
+	0, 114, 0, 0, 154, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
+	0, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 131, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 210, 211, 0,
+	0, 207, 0, 0, 0, 212, 139, 0, 157, 103,
+	111, 72, 79, 0, 102, 129, 144, 148, 0, 0,
+	0, 89, 0, 146, 134, 169, 0, 135, 145, 115,
+	162, 140, 0, 177, 178, 159, 175, 185, 73, 158,
+	168, 86, 149, 75, 166, 156, 121, 107, 108, 74,
+	0, 143, 93, 99, 91, 130, 163, 164, 90, 188,
+	80, 174, 77, 81, 173, 128, 161, 167, 122, 119,
+	76, 165, 120, 118, 110, 97, 104, 137, 117, 138,
+	105, 125, 124, 126, 0, 0, 0, 155, 171, 189,
+	83, 0, 150, 160, 179, 180, 181, 182, 183, 184,
+	0, 0, 84, 100, 95, 136, 127, 82, 106, 151,
+	109, 116, 142, 187, 133, 147, 87, 170, 153, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 26, 0, 0, 0, 0, 71, 78,
+	113, 0, 141, 98, 172, 132, 0, 186, 92, 88,
+	70, 0, 0, 0, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 94, 0, 0, 0, 0, 0,
+	112, 0, 114, 0, 0, 154, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 131, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 139, 0, 157,
+	103, 111, 72, 79, 0, 102, 129, 144, 148, 0,
+	0, 0, 89, 0, 146, 134, 169, 0, 135, 145,
+	115, 162, 140, 0, 177, 178, 159, 175, 185, 73,
+	158, 168, 86, 149, 75, 166, 156, 121, 107, 108,
+	74, 0, 143, 93, 99, 91, 130, 163, 164, 90,
+	188, 80, 174, 77, 81, 173, 128, 161, 167, 122,
+	119, 76, 165, 120, 118, 110, 97, 104, 137, 117,
+	138, 105, 125, 124, 126, 0, 0, 0, 155, 171,
+	189, 83, 0, 150, 160, 179, 180, 181, 182, 183,
+	184, 0, 0, 84, 100, 95, 136, 127, 82, 106,
+	151, 109, 116, 142, 187, 133, 147, 87, 170, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 26, 0, 0, 0, 0, 71,
+	78, 113, 23, 141, 98, 172, 132, 0, 186, 92,
+	88, 70, 0, 0, 0, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 0,
+	0, 112, 0, 114, 0, 0, 154, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 0, 0, 667, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 131, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 176, 0, 0, 0, 0, 139, 0,
+	157, 103, 111, 72, 79, 0, 102, 129, 144, 148,
+	0, 0, 0, 89, 0, 146, 134, 169, 0, 135,
+	145, 115, 162, 140, 0, 177, 178, 159, 175, 185,
+	73, 158, 168, 86, 149, 75, 166, 156, 121, 107,
+	108, 74, 0, 143, 93, 99, 91, 130, 163, 164,
+	90, 188, 80, 174, 77, 81, 173, 128, 161, 167,
+	122, 119, 76, 165, 120, 118, 110, 97, 104, 137,
+	117, 138, 105, 125, 124, 126, 0, 0, 0, 155,
+	171, 189, 83, 0, 150, 160, 179, 180, 181, 182,
+	183, 184, 0, 0, 84, 100, 95, 136, 127, 82,
+	106, 668, 109, 116, 142, 187, 133, 147, 87, 170,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	71, 78, 113, 23, 141, 98, 172, 132, 0, 186,
+	92, 88, 70, 0, 0, 0, 0, 0, 152, 0,
+	0, 921, 0, 0, 0, 0, 94, 0, 0, 0,
+	0, 0, 112, 0, 114, 0, 0, 154, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 68, 0, 67,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 131, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 139,
+	0, 157, 103, 111, 72, 79, 0, 102, 129, 144,
+	148, 0, 0, 0, 89, 0, 146, 134, 169, 0,
+	135, 145, 115, 162, 140, 0, 177, 178, 159, 175,
+	185, 73, 158, 168, 86, 149, 75, 166, 156, 121,
+	107, 108, 74, 0, 143, 93, 99, 91, 130, 163,
+	164, 90, 188, 80, 174, 77, 81, 173, 128, 161,
+	167, 122, 119, 76, 165, 120, 118, 110, 97, 104,
+	137, 117, 138, 105, 125, 124, 126, 0, 0, 0,
+	155, 171, 189, 83, 0, 150, 160, 179, 180, 181,
+	182, 183, 184, 0, 0, 84, 100, 95, 136, 127,
+	82, 106, 151, 109, 116, 142, 187, 133, 147, 87,
+	170, 153, 0, 0, 0, 132, 0, 186, 92, 88,
+	70, 0, 0, 0, 0, 0, 152, 0, 0, 0,
+	0, 71, 78, 113, 94, 141, 98, 172, 0, 0,
+	112, 0, 114, 0, 0, 154, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 854, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 856, 857, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 131, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 139, 0, 157,
+	103, 111, 72, 79, 0, 102, 129, 144, 148, 0,
+	0, 0, 89, 0, 146, 134, 169, 0, 135, 145,
+	115, 162, 140, 0, 177, 178, 159, 175, 185, 73,
+	158, 168, 86, 149, 75, 166, 156, 121, 107, 108,
+	74, 0, 143, 93, 99, 91, 130, 163, 164, 90,
+	188, 80, 174, 77, 81, 173, 128, 161, 167, 122,
+	119, 76, 165, 120, 118, 110, 97, 104, 137, 117,
+	138, 105, 125, 124, 126, 0, 0, 0, 155, 171,
+	189, 83, 0, 150, 160, 179, 180, 181, 182, 183,
+	184, 0, 0, 84, 100, 95, 136, 127, 82, 106,
+	151, 109, 116, 142, 187, 133, 147, 87, 170, 153,
+	0, 0, 0, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 0, 152, 0, 0, 921, 0, 71,
+	78, 113, 94, 141, 98, 172, 0, 0, 112, 0,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 68, 0, 67, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 0, 919, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 0, 0,
+	0, 132, 0, 186, 92, 88, 70, 0, 0, 0,
+	0, 0, 152, 0, 0, 0, 0, 71, 78, 113,
+	94, 141, 98, 172, 0, 0, 112, 0, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 804, 0, 0, 805, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 0, 0, 0, 0,
+	0, 0, 0, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 0, 152, 71, 78, 113, 0, 141,
+	98, 172, 94, 0, 690, 0, 0, 0, 112, 0,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 689, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 0, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 0, 0,
+	0, 132, 0, 186, 92, 88, 70, 0, 63, 0,
+	0, 0, 152, 0, 0, 0, 0, 71, 78, 113,
+	94, 141, 98, 172, 0, 0, 112, 0, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 68, 0, 67, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 0, 0, 0, 132,
+	0, 186, 92, 88, 70, 0, 0, 0, 0, 0,
+	152, 0, 0, 0, 0, 71, 78, 113, 94, 141,
+	98, 172, 0, 0, 112, 0, 114, 0, 0, 154,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 667,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 131, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 139, 0, 157, 103, 111, 72, 79, 0, 102,
+	129, 144, 148, 0, 0, 0, 89, 0, 146, 134,
+	169, 0, 135, 145, 115, 162, 140, 0, 177, 178,
+	159, 175, 185, 73, 158, 168, 86, 149, 75, 166,
+	156, 121, 107, 108, 74, 0, 143, 93, 99, 91,
+	130, 163, 164, 90, 188, 80, 174, 77, 81, 173,
+	128, 161, 167, 122, 119, 76, 165, 120, 118, 110,
+	97, 104, 137, 117, 138, 105, 125, 124, 126, 0,
+	0, 0, 155, 171, 189, 83, 0, 150, 160, 179,
+	180, 181, 182, 183, 184, 0, 0, 84, 100, 95,
+	136, 127, 82, 106, 668, 109, 116, 142, 187, 133,
+	147, 87, 170, 153, 0, 0, 0, 0, 0, 0,
+	0, 132, 0, 186, 92, 88, 70, 0, 0, 0,
+	0, 0, 152, 71, 78, 113, 0, 141, 98, 172,
+	94, 1121, 0, 0, 0, 0, 112, 0, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 0, 0, 0, 132,
+	0, 186, 92, 88, 70, 0, 0, 0, 0, 0,
+	152, 0, 0, 0, 0, 71, 78, 113, 94, 141,
+	98, 172, 0, 0, 112, 0, 114, 0, 0, 154,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 68,
+	0, 67, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 131, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 139, 0, 157, 103, 111, 72, 79, 0, 102,
+	129, 144, 148, 0, 0, 0, 89, 0, 146, 134,
+	169, 0, 135, 145, 115, 162, 140, 0, 177, 178,
+	159, 175, 185, 73, 158, 168, 86, 149, 75, 166,
+	156, 121, 107, 108, 74, 0, 143, 93, 99, 91,
+	130, 163, 164, 90, 188, 80, 174, 77, 81, 173,
+	128, 161, 167, 122, 119, 76, 165, 120, 118, 110,
+	97, 104, 137, 117, 138, 105, 125, 124, 126, 0,
+	0, 0, 155, 171, 189, 83, 0, 150, 160, 179,
+	180, 181, 182, 183, 184, 0, 0, 84, 100, 95,
+	136, 127, 82, 106, 151, 109, 116, 142, 187, 133,
+	147, 87, 170, 153, 0, 0, 0, 132, 0, 186,
+	92, 88, 70, 0, 0, 0, 0, 0, 152, 0,
+	0, 0, 0, 71, 78, 113, 94, 141, 98, 172,
+	0, 0, 112, 0, 114, 0, 0, 154, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 569,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 131, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 139,
+	0, 157, 103, 111, 72, 79, 0, 102, 129, 144,
+	148, 0, 0, 0, 89, 0, 146, 134, 169, 0,
+	135, 145, 115, 162, 140, 0, 177, 178, 159, 175,
+	185, 73, 158, 168, 86, 149, 75, 166, 156, 121,
+	107, 108, 74, 0, 143, 93, 99, 91, 130, 163,
+	164, 90, 188, 80, 174, 77, 81, 173, 128, 161,
+	167, 122, 119, 76, 165, 120, 118, 110, 97, 104,
+	137, 117, 138, 105, 125, 124, 126, 0, 0, 0,
+	155, 171, 189, 83, 0, 150, 160, 179, 180, 181,
+	182, 183, 184, 0, 0, 84, 100, 95, 136, 127,
+	82, 106, 151, 109, 116, 142, 187, 133, 147, 87,
+	170, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 132, 0, 186, 92, 88, 70, 0,
+	0, 71, 78, 113, 152, 141, 98, 172, 0, 0,
+	0, 658, 94, 0, 0, 0, 0, 0, 112, 0,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 68, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 0, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 355, 0,
+	0, 0, 0, 0, 0, 132, 0, 186, 92, 88,
+	70, 0, 0, 0, 0, 0, 152, 71, 78, 113,
+	0, 141, 98, 172, 94, 0, 0, 0, 0, 0,
+	112, 0, 114, 0, 0, 154, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 68, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 131, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 139, 0, 157,
+	103, 111, 72, 79, 0, 102, 129, 144, 148, 0,
+	0, 0, 89, 0, 146, 134, 169, 0, 135, 145,
+	115, 162, 140, 0, 177, 178, 159, 175, 185, 73,
+	158, 168, 86, 149, 75, 166, 156, 121, 107, 108,
+	74, 0, 143, 93, 99, 91, 130, 163, 164, 90,
+	188, 80, 174, 77, 81, 173, 128, 161, 167, 122,
+	119, 76, 165, 120, 118, 110, 97, 104, 137, 117,
+	138, 105, 125, 124, 126, 0, 0, 0, 155, 171,
+	189, 83, 0, 150, 160, 179, 180, 181, 182, 183,
+	184, 0, 0, 84, 100, 95, 136, 127, 82, 106,
+	151, 109, 116, 142, 187, 133, 147, 87, 170, 153,
+	0, 0, 0, 132, 0, 186, 92, 88, 70, 0,
+	0, 0, 0, 0, 152, 0, 0, 0, 0, 71,
+	78, 113, 94, 141, 98, 172, 0, 0, 112, 0,
+	114, 0, 0, 154, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 68, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 226, 0, 0,
+	176, 0, 0, 0, 0, 139, 0, 157, 103, 111,
+	72, 79, 0, 102, 129, 144, 148, 0, 0, 0,
+	89, 0, 146, 134, 169, 0, 135, 145, 115, 162,
+	140, 0, 177, 178, 159, 175, 185, 73, 158, 168,
+	86, 149, 75, 166, 156, 121, 107, 108, 74, 0,
+	143, 93, 99, 91, 130, 163, 164, 90, 188, 80,
+	174, 77, 81, 173, 128, 161, 167, 122, 119, 76,
+	165, 120, 118, 110, 97, 104, 137, 117, 138, 105,
+	125, 124, 126, 0, 0, 0, 155, 171, 189, 83,
+	0, 150, 160, 179, 180, 181, 182, 183, 184, 0,
+	0, 84, 100, 95, 136, 127, 82, 106, 151, 109,
+	116, 142, 187, 133, 147, 87, 170, 153, 0, 0,
+	0, 132, 0, 186, 92, 88, 70, 0, 0, 0,
+	0, 0, 152, 0, 0, 0, 0, 71, 78, 113,
+	94, 141, 98, 172, 0, 0, 112, 0, 114, 0,
+	0, 154, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 139, 0, 157, 103, 111, 72, 79,
+	0, 102, 129, 144, 148, 0, 0, 0, 89, 0,
+	146, 134, 169, 0, 135, 145, 115, 162, 140, 0,
+	177, 178, 159, 175, 185, 73, 158, 168, 86, 149,
+	75, 166, 156, 121, 107, 108, 74, 0, 143, 93,
+	99, 91, 130, 163, 164, 90, 188, 80, 174, 77,
+	81, 173, 128, 161, 167, 122, 119, 76, 165, 120,
+	118, 110, 97, 104, 137, 117, 138, 105, 125, 124,
+	126, 0, 0, 0, 155, 171, 189, 83, 0, 150,
+	160, 179, 180, 181, 182, 183, 184, 0, 0, 84,
+	100, 95, 136, 127, 82, 106, 151, 109, 116, 142,
+	187, 133, 147, 87, 170, 153, 0, 0, 0, 132,
+	0, 186, 92, 88, 70, 0, 0, 0, 0, 0,
+	152, 0, 0, 0, 0, 71, 78, 113, 94, 141,
+	98, 172, 0, 0, 112, 0, 114, 0, 0, 154,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 632, 91,
-	0, 0, 0, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	65, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 131, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 341, 0, 0,
-	0, 0, 0, 0, 129, 0, 182, 89, 85, 67,
-	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
-	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 0, 182, 89, 85, 67, 0, 0,
-	0, 0, 68, 75, 110, 91, 138, 95, 168, 0,
-	0, 109, 0, 111, 0, 0, 150, 120, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	219, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 68, 75,
-	110, 91, 138, 95, 168, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 65, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 0, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 68, 75, 110, 91,
-	138, 95, 168, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
+	0, 101, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 139, 0, 157, 103, 111, 72, 79, 0, 102,
+	129, 144, 148, 0, 0, 0, 89, 0, 146, 134,
+	169, 0, 135, 145, 115, 162, 140, 0, 177, 178,
+	159, 175, 185, 73, 158, 168, 86, 149, 75, 166,
+	156, 121, 107, 108, 74, 0, 143, 93, 99, 91,
+	130, 163, 164, 90, 188, 80, 174, 77, 81, 173,
+	128, 161, 167, 122, 119, 76, 165, 120, 118, 110,
+	97, 104, 137, 117, 138, 105, 125, 124, 126, 0,
+	0, 0, 155, 171, 189, 83, 0, 150, 160, 179,
+	180, 181, 182, 183, 184, 0, 0, 84, 100, 95,
+	136, 127, 82, 106, 151, 109, 116, 142, 187, 133,
+	147, 87, 170, 153, 0, 0, 0, 132, 0, 186,
+	92, 88, 70, 0, 0, 0, 0, 0, 152, 0,
+	0, 0, 0, 71, 78, 113, 94, 141, 98, 172,
+	0, 0, 112, 0, 114, 0, 0, 154, 123, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 131, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 230, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 75, 110, 0, 138, 95,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 234, 0, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 139,
+	0, 157, 103, 111, 72, 79, 0, 102, 129, 144,
+	148, 0, 0, 0, 89, 0, 146, 134, 169, 0,
+	135, 145, 115, 162, 140, 0, 177, 178, 159, 175,
+	185, 73, 158, 168, 86, 149, 75, 166, 156, 121,
+	107, 108, 74, 0, 143, 93, 99, 91, 130, 163,
+	164, 90, 188, 80, 174, 77, 81, 173, 128, 161,
+	167, 122, 119, 76, 165, 120, 118, 110, 97, 104,
+	137, 117, 138, 105, 125, 124, 126, 0, 0, 0,
+	155, 171, 189, 83, 0, 150, 160, 179, 180, 181,
+	182, 183, 184, 0, 0, 84, 100, 95, 136, 127,
+	82, 106, 151, 109, 116, 142, 187, 133, 147, 87,
+	170, 153, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 226, 227,
-	0, 237, 238, 239, 241, 0, 240, 246, 0, 0,
-	0, 228, 231, 0, 224, 245, 244,
+	0, 71, 78, 113, 0, 141, 98, 172,
 }
 
-var yyPact = [...]int{
-	1745, -1000, -200, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	1680, -1000, -205, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 910, 12205, 939, -1000, -1000, -1000, -1000, -1000,
-	-1000, 280, 9931, -14, 150, 51, 13214, 149, 14159, 13710,
-	-1000, -4, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -103,
-	-105, -1000, 94, -1000, -1000, -1000, -1000, -1000, 894, 907,
-	683, -1000, 868, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1056, 13461, 1116, 1079, -1000, -1000, -1000, -1000,
+	-1000, -1000, 447, 11404, 20, 161, 111, 15213, 155, 2612,
+	15709, -1000, 12, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-75, -110, -1000, -1000, -1000, -1000, 157, -1000, -1000, -1000,
+	1048, 1054, 812, 14209, -1000, 866, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2221,25 +2446,26 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 707, 867, 795, -1000,
-	7828, 113, 113, 12966, 6237, -1000, -1000, 373, 13710, 142,
-	13710, -162, 109, 109, 109, -1000, -1000, -1000, -1000, 147,
-	13710, 382, -1000, 13710, 101, 544, 101, 101, 101, 13710,
-	-1000, 196, 13710, 522, 3735, 297, 3735, 3735, -1000, 3735,
-	3735, -1000, 3735, 2, 3735, -2, 929, -1000, -1000, -1000,
-	-1000, -43, -1000, 3735, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 547, 840, 8623,
-	8623, 94, 12205, 685, 910, -1000, 94, -1000, -1000, -1000,
-	826, -1000, -1000, 379, 942, -1000, 2822, 195, 52, -1000,
-	8623, 685, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9418,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, -1000, -1000, -1000,
-	-1000, 685, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 685, -1000, 7033, 685, 685, 685, 685, 685,
-	685, 685, 685, 8623, 685, 685, 685, 685, 685, 685,
-	685, 685, 685, 685, 685, 685, 685, 685, 685, 12718,
-	11957, 13710, 682, 645, -1000, -1000, 194, 674, 5959, -130,
-	-1000, -1000, -1000, 287, 11709, -1000, -1000, -1000, 828, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	868, 1031, 1027, 868, 1023, 957, -1000, 9259, 119, 119,
+	14965, 7090, -1000, -1000, 350, 15709, 146, 15709, -166, 114,
+	114, 114, -1000, -1000, -1000, -1000, 154, 15709, 310, -1000,
+	15709, 112, 637, 112, 112, 112, 15709, -1000, 227, 15709,
+	622, 4516, 48, 4516, 4516, -1000, 4516, 4516, -1000, 4516,
+	31, 4516, -59, 1069, -1000, -1000, -1000, -1000, -25, -1000,
+	4516, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 650, 1010, 10072, 10072, 157, 14209,
+	812, 823, 15461, 1063, -1000, -1000, -1000, -1000, -1000, -1000,
+	1056, -1000, -1000, 988, -1000, -1000, 443, 1078, -1000, 3573,
+	226, 24, -1000, 10072, 823, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 10885, 10885, 10885, 10885, 10885, 10885, 10885, 10885,
+	-1000, -1000, -1000, -1000, 823, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 823, -1000, 8446, 823, 823,
+	823, 823, 823, 823, 823, 823, 823, 10072, 823, 823,
+	823, 823, 823, 823, 823, 823, 823, 823, 823, 823,
+	823, 823, 823, 14713, 13709, 15709, 753, 736, -1000, -1000,
+	224, 793, 6804, -104, -1000, -1000, -1000, 280, 13213, -1000,
+	-1000, -1000, 996, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2248,290 +2474,304 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 611, 13710, -1000, 2501,
-	-1000, 515, 3735, 118, 512, 342, 509, 13710, 13710, 3735,
-	25, 74, 145, 13710, 679, 116, 13710, 858, 754, 13710,
-	507, 504, -1000, 5681, -1000, 3735, -1000, -1000, -1000, 3735,
-	3735, 3735, 13710, 3735, 3735, -1000, -1000, -1000, -1000, -1000,
-	3735, 3735, -1000, 940, 340, -1000, -1000, -1000, -1000, 8623,
-	-1000, 750, -1000, -1000, -1000, -1000, -1000, -1000, 956, 238,
-	437, 1937, 191, 678, -1000, 444, -1000, -1000, 94, 894,
-	547, 795, 11457, 765, -1000, -1000, 13710, -1000, 8623, 8623,
-	521, -1000, 12453, -1000, -1000, 4569, -1000, 9418, 416, 346,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 478,
-	9418, 10961, 13462, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	230, -1000, 497, 53, 53, 53, 53, 53, 53, 53,
-	9683, -1000, 94, 7298, 547, 607, 496, 7033, 7828, 7828,
-	8623, 8623, 8358, 8093, 7828, 871, 335, 496, 13958, -1000,
-	-1000, 9153, -1000, -1000, -1000, -1000, -1000, 547, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 13462, 13462, 7828, 7828, 7828,
-	7828, 61, 13710, -1000, 635, 997, -1000, -1000, -1000, 861,
-	10448, 685, 11209, 61, 628, 11957, 13710, -1000, -1000, 11957,
-	13710, 4291, 5403, 674, -130, 642, -1000, -126, -137, 6767,
-	206, -1000, -1000, -1000, -1000, 3457, 313, 602, 464, -92,
-	-1000, -1000, -1000, 690, -1000, 690, 690, 690, 690, -61,
-	-61, -61, -61, -1000, -1000, -1000, -1000, -1000, 708, 706,
-	-1000, 690, 690, 690, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 699, 699, 699, 697, 697, 728, -1000, 13710,
-	3735, 855, 3735, -1000, 417, -1000, 13462, 13462, 13710, 13710,
-	157, 13710, 13710, 654, -1000, 13710, 3735, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 724, 15709, -1000,
+	2417, -1000, 620, 4516, 135, 606, 354, 604, 15709, 15709,
+	4516, 57, 73, 152, 15709, 809, 122, 15709, 1015, 886,
+	15709, 602, 600, -1000, 6518, -1000, 4516, -1000, -1000, -1000,
+	4516, 4516, 4516, 15709, 4516, 4516, -1000, -1000, -1000, -1000,
+	-1000, 4516, 4516, -1000, 1077, 400, -1000, -1000, -1000, -1000,
+	10072, -1000, 884, -1000, -1000, -1000, -1000, -1000, -1000, 1087,
+	260, 671, 285, 222, 807, -1000, 539, -1000, -1000, 157,
+	157, 673, 206, 1048, 868, 957, 1048, 12961, 905, -1000,
+	-1000, 15709, -1000, 10072, 10072, 556, -1000, 14457, -1000, -1000,
+	5374, -1000, 10885, 457, 440, 10885, 10885, 10885, 10885, 10885,
+	10885, 10885, 10885, 10885, 10885, 10885, 10885, 10885, 10885, 10885,
+	10885, 10885, 10885, 10885, 523, 10885, 12465, 15461, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 264, -1000, 596, 26, 26,
+	26, 26, 26, 26, 26, 11156, -1000, 157, 8717, 650,
+	615, 545, 8446, 9259, 9259, 9259, 10072, 10072, 9801, 9530,
+	9259, 1024, 374, 545, 15957, -1000, -1000, 10614, -1000, -1000,
+	-1000, -1000, -1000, 650, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 15461, 15461, 9259, 9259, 9259, 9259, 77, 15709, -1000,
+	754, 972, -1000, -1000, -1000, 1017, 11946, 823, 823, 12713,
+	77, 739, 13709, 15709, -1000, -1000, 13709, 15709, 5088, 6232,
+	793, -104, 780, -1000, -136, -140, 8174, 236, -1000, -1000,
+	-1000, -1000, 4230, 727, 726, 508, -53, -1000, -1000, -1000,
+	835, -1000, 835, 835, 835, 835, -13, -13, -13, -13,
+	-1000, -1000, -1000, -1000, -1000, 855, 854, -1000, 835, 835,
+	835, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 851,
+	851, 851, 850, 850, 861, -1000, 15709, 4516, 1013, 4516,
+	-1000, 92, -1000, 15461, 15461, 15709, 15709, 179, 15709, 15709,
+	792, -1000, 15709, 4516, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15709, 518,
+	15709, 15709, 545, 15709, -1000, 969, 10072, 10072, 5946, 10072,
+	-1000, -1000, -1000, -1000, 650, 1018, 15461, 15461, 1010, -1000,
+	1024, 1010, 1053, -1000, 980, 979, 9259, -1000, -1000, 264,
+	401, -1000, -1000, 572, -1000, -1000, -1000, -1000, 193, 823,
+	-1000, 2452, -1000, -1000, -1000, -1000, 457, 10885, 10885, 10885,
+	1829, 2452, 2452, 2452, 2452, 2452, 2611, 383, 89, 26,
+	409, 409, 29, 29, 29, 29, 29, 544, 544, -1000,
+	-1000, -1000, 495, -1000, -1000, -1000, -1000, -1000, -1000, 650,
+	-1000, 650, 9259, 791, -1000, -1000, 10072, -1000, 650, 710,
+	710, 710, 627, 515, 1076, 1075, 710, 1074, 1065, 710,
+	710, 9259, 494, -1000, 10072, 650, -1000, 192, -1000, 1472,
+	786, 784, 710, 650, 710, 710, 151, 823, -1000, 15957,
+	13709, 907, 13709, 13709, 13709, -1000, -1000, -1000, 954, 931,
+	935, 899, 15709, -1000, 721, 11946, 13961, 13961, 212, 823,
+	-1000, 14209, 1068, 13709, 758, -1000, 758, -1000, 190, -1000,
+	-1000, 780, -104, -79, -1000, -1000, -1000, -1000, 545, -1000,
+	558, 778, 3944, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	848, 581, -1000, 1006, 233, 395, 579, 1004, -1000, -1000,
+	-1000, 998, -1000, 407, -67, -1000, -1000, 492, -13, -13,
+	-1000, -1000, 236, 991, 236, 236, 236, 559, 559, -1000,
+	-1000, -1000, -1000, 484, -1000, -1000, -1000, 479, -1000, 883,
+	15461, 4516, -1000, -1000, -1000, -1000, 711, 711, 552, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 13710, 355, 13710, 13710, 496, 13710, -1000, 807, 8623,
-	8623, 5125, 8623, -1000, -1000, -1000, 547, 840, -1000, 871,
-	916, -1000, 820, 818, 7828, -1000, -1000, 230, 277, -1000,
-	-1000, 524, -1000, -1000, -1000, -1000, 184, 685, -1000, 3040,
-	-1000, -1000, -1000, -1000, 416, 9418, 9418, 9418, 3011, 3040,
-	3040, 3040, 3040, 3040, 9919, 2657, 2348, 53, 72, 72,
-	11, 11, 11, 11, 11, 881, 881, -1000, -1000, -1000,
-	163, -1000, -1000, -1000, -1000, -1000, -1000, 547, -1000, 547,
-	7828, 651, -1000, -1000, 8623, -1000, 547, 594, 594, 402,
-	452, 937, 936, 594, 935, 934, 594, 594, 7828, 411,
-	-1000, 8623, 547, -1000, 181, -1000, 1741, 649, 644, 594,
-	547, 594, 594, 73, 685, -1000, 13958, 11957, 777, 11957,
-	11957, 11957, -1000, -1000, -1000, 785, 783, 792, 767, 13710,
-	-1000, 600, 10448, 13462, 205, 685, -1000, 12205, 928, 11957,
-	630, -1000, 630, -1000, 180, -1000, -1000, 642, -130, -140,
-	-1000, -1000, -1000, -1000, 496, -1000, 501, 641, 3179, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 694, 492, -1000, 846,
-	227, 252, 489, 845, -1000, -1000, -1000, 832, -1000, 362,
-	-96, -1000, -1000, 442, -61, -61, -1000, -1000, 206, 827,
-	206, 206, 206, 466, 466, -1000, -1000, -1000, -1000, 439,
-	-1000, -1000, -1000, 438, -1000, 745, 13462, 3735, -1000, -1000,
-	-1000, -1000, 653, 653, 226, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 50, 713, -1000, -1000,
-	-1000, 22, 21, 115, -1000, 3735, -1000, 340, -1000, 462,
-	8623, -1000, -1000, -1000, 804, 496, 496, 174, -1000, -1000,
-	-1000, 13710, -1000, -1000, -1000, -1000, 671, -1000, -1000, -1000,
-	4013, 7828, -1000, 3011, 3040, 2702, -1000, 9418, 9418, -1000,
-	-1000, -1000, 594, 7828, 496, -1000, -1000, -1000, 10961, 478,
-	10961, 9418, 9418, -1000, 9418, 9418, -1000, -175, 650, 329,
-	-1000, 8623, 441, -1000, 5125, -1000, 9418, 9418, -1000, -1000,
-	-1000, -1000, 744, 13958, 685, -1000, 10196, 13462, 646, -1000,
-	281, 997, 11957, -1000, 790, 788, 738, 969, -1000, -1000,
-	769, -1000, 768, -1000, -1000, -1000, -1000, -1000, 547, 638,
-	-1000, 233, -1000, 141, 126, 124, 13462, -1000, 910, 8623,
-	630, -1000, -1000, 216, -1000, -1000, -145, -146, -1000, -1000,
-	-1000, 3457, -1000, 3457, 13462, 82, -1000, 489, 489, -1000,
-	-1000, -1000, 692, 737, 9418, -1000, -1000, -1000, 571, 206,
-	206, -1000, 260, -1000, -1000, -1000, 584, -1000, 578, 637,
-	574, 13710, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13710, -1000,
-	-1000, -1000, -1000, -1000, 13462, -188, 482, 13462, 13462, 13710,
-	-1000, 355, -1000, 496, -1000, 4847, -1000, 928, 11957, -1000,
-	-1000, 547, -1000, 9418, 3040, 3040, -1000, -1000, 547, 547,
-	547, 2633, 2449, 2196, 2099, 685, -170, -1000, 496, 8623,
-	-1000, 1828, 1579, -1000, 829, 616, 629, -1000, -1000, 7563,
-	547, 570, 168, 568, -1000, 910, 13958, 8623, 735, -1000,
-	-1000, -1000, 8623, -1000, 8623, 691, -1000, -1000, 861, 13462,
-	6502, 685, 685, 685, 568, 894, 496, -1000, -1000, -1000,
-	-1000, 3179, -1000, 563, -1000, 690, -1000, -1000, -1000, 13462,
-	-87, 955, 3040, -1000, -1000, -1000, -1000, -1000, -61, 461,
-	-61, 435, -1000, 424, 3735, -1000, -1000, -1000, -1000, 849,
-	-1000, 4847, -1000, -1000, 688, -1000, -1000, -1000, 919, 634,
-	-1000, 3040, -1000, -1000, -1000, 9418, 9418, 9418, 9418, 9418,
-	547, 455, 496, 9418, 9418, 844, -1000, 685, -1000, -1000,
-	79, 13462, 13462, -1000, 13462, 894, -1000, 496, -1000, -1000,
-	496, 496, 13462, 13710, -1000, -1000, 496, 685, 685, 13462,
-	13462, 13462, 10713, -1000, 258, 13462, -1000, 561, -1000, 210,
-	-1000, -57, 206, -1000, 206, 564, 556, -1000, 685, 632,
-	-1000, 271, 13462, 915, 904, 1741, 1741, 1741, 1741, 1435,
-	-1000, -1000, 1741, 1741, 946, -1000, 685, -1000, 94, 164,
-	-1000, -1000, -1000, 559, -1000, 11957, 13958, 553, 553, 553,
-	205, 258, -1000, 473, 257, 454, -1000, 64, 13462, 367,
-	842, -1000, 835, -1000, -1000, -1000, -1000, -1000, 48, 4847,
-	3457, 551, 27, 8623, 8623, -1000, -1000, -1000, -1000, 547,
-	44, -191, -1000, -1000, 13958, 629, 547, 13462, -1000, 856,
-	547, -1000, -1000, -1000, -1000, -1000, -1000, 396, -1000, -1000,
-	13710, -1000, -1000, 450, -1000, -1000, 543, -1000, 13462, -1000,
-	-1000, 713, -1000, 752, 496, 627, -1000, 803, -186, -194,
-	618, -1000, -1000, -1000, -1000, -1000, 687, -1000, -1000, 48,
-	817, -188, 614, -1000, 395, 872, 8623, -1000, 800, -1000,
-	13462, -1000, 45, -1000, 752, -1000, 261, 8623, 496, -189,
-	527, 41, -1000, 960, 496, -192, 733, 685, -1000, -195,
-	731, -1000, 933, 8888, -1000, -1000, 945, 266, 266, 1741,
-	547, -1000, -1000, -1000, 92, 401, -1000, -1000, -1000, -1000,
-	-1000, -1000,
+	75, 859, -1000, -1000, -1000, 49, 40, 113, -1000, 4516,
+	-1000, 400, -1000, 547, 10072, -1000, -1000, -1000, 965, 545,
+	545, 187, -1000, -1000, 823, 181, -1000, -1000, -1000, 15709,
+	-1000, -1000, -1000, -1000, 781, -1000, -1000, -1000, 4802, 9259,
+	-1000, 1829, 2452, 2422, -1000, 10885, 10885, -1000, -1000, 1035,
+	710, 9259, 545, -1000, -1000, -1000, -1000, 12465, 523, 12465,
+	10885, 10885, -1000, 10885, 10885, -1000, -179, 765, 343, -1000,
+	10072, 451, -1000, 5946, -1000, 10885, 10885, -1000, -1000, -1000,
+	-1000, 878, 15957, 823, -1000, 11675, 15461, 766, -1000, 275,
+	972, 13709, -1000, 934, 922, 877, 874, -1000, -1000, 913,
+	-1000, 910, -1000, -1000, -1000, -1000, -1000, 650, 777, -1000,
+	257, 256, 650, -1000, 144, 141, 138, 15461, -1000, 1056,
+	10072, 758, -1000, -1000, 244, -1000, -1000, -144, -151, -1000,
+	-1000, -1000, 4230, -1000, 4230, 15461, 99, -1000, 579, 579,
+	-1000, -1000, -1000, 847, 876, 10885, -1000, -1000, -1000, 688,
+	236, 236, -1000, 294, -1000, -1000, -1000, 706, -1000, 694,
+	773, 692, 15709, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15709,
+	-1000, -1000, -1000, -1000, -1000, 15461, -192, 566, 15461, 15461,
+	15709, -1000, 518, -1000, 545, -1000, 5660, 157, 15461, -1000,
+	1068, 13709, -1000, -1000, 650, -1000, 10885, 2452, 2452, 823,
+	-1000, -1000, 650, 650, 650, 2234, 1972, 1911, 1780, 823,
+	-173, -1000, 545, 10072, -1000, 1641, 1340, -1000, 1008, 735,
+	750, -1000, -1000, 8988, 650, 673, 666, -1000, 1056, 15957,
+	10072, 846, -1000, -1000, -1000, 10072, -1000, 10072, 840, -1000,
+	-1000, 1017, 13961, 7903, 7903, 1017, 823, 823, 823, 666,
+	1048, 545, -1000, -1000, -1000, -1000, 3944, -1000, 655, -1000,
+	835, -1000, -1000, -1000, 15461, -48, 1086, 2452, -1000, -1000,
+	-1000, -1000, -1000, -13, 532, -13, 471, -1000, 467, 4516,
+	-1000, -1000, -1000, -1000, 1011, -1000, 5660, -1000, -1000, 832,
+	-1000, -1000, -1000, 650, -1000, 1064, 764, -1000, 2452, 74,
+	-1000, -1000, -1000, 10885, 10885, 10885, 10885, 10885, 650, 528,
+	545, 10885, 10885, 1002, -1000, 823, -1000, -1000, 176, -1000,
+	15461, 1048, -1000, 545, -1000, -1000, 545, 545, 15461, 15709,
+	-1000, -1000, 545, 823, 823, -1000, 15709, 15461, 15461, 15461,
+	12217, -1000, 201, 15461, -1000, 649, -1000, 263, -1000, -95,
+	236, -1000, 236, 656, 652, -1000, 823, 759, -1000, 273,
+	15461, -1000, 1060, 1051, 650, 1056, 1050, 1472, 1472, 1472,
+	1472, 360, -1000, -1000, 1472, 1472, 1084, -1000, 823, -1000,
+	157, -1000, -1000, 643, -1000, 13709, 15957, -1000, 628, 628,
+	628, 212, 201, -1000, 498, 272, 527, -1000, 84, 15461,
+	430, 1001, -1000, 1000, -1000, -1000, -1000, -1000, -1000, 71,
+	5660, 4230, 619, 43, 10072, 7632, -1000, -1000, 10072, -1000,
+	-1000, -1000, -1000, 650, 42, -195, -1000, -1000, 15957, 750,
+	650, -1000, 782, 650, -1000, -1000, -1000, -1000, -1000, -1000,
+	460, -1000, -1000, 15709, -1000, -1000, 524, -1000, -1000, 617,
+	-1000, 15461, -1000, -1000, 859, -1000, 885, 545, 749, -1000,
+	545, 823, 823, 783, 741, -1000, 960, -186, -200, 740,
+	-1000, -1000, -1000, -1000, 829, -1000, -1000, 71, 978, -192,
+	738, -1000, 538, 1042, 10072, 7632, 10072, 10072, 823, -1000,
+	939, -1000, 15461, -1000, 66, -1000, 885, -1000, 327, 10072,
+	10072, 545, -1000, 615, 615, 10072, -193, 613, 51, -1000,
+	1090, 545, 545, -1000, -1000, 611, -1000, 7361, 545, -196,
+	873, 823, -1000, -1000, 10072, -1000, -202, 872, -1000, 1073,
+	10343, -1000, -1000, -1000, 1081, 427, 427, 1472, 650, -1000,
+	-1000, -1000, 103, 496, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 
-var yyPgo = [...]int{
-	0, 1199, 50, 173, 1196, 1193, 1192, 106, 1191, 1190,
-	1189, 1188, 1187, 1186, 1185, 1184, 1182, 1181, 1180, 1179,
-	1170, 1168, 1167, 1164, 1163, 1162, 1161, 1157, 1156, 219,
-	1154, 1153, 1150, 74, 1148, 75, 1147, 1146, 53, 222,
-	54, 49, 376, 1142, 31, 27, 52, 1138, 1135, 1134,
-	23, 1130, 25, 1128, 1127, 76, 1125, 1124, 60, 1122,
-	1120, 112, 1119, 71, 1118, 12, 35, 1117, 1116, 1112,
-	1111, 1110, 881, 1109, 1108, 17, 1106, 1103, 97, 1101,
-	62, 7, 15, 13, 21, 1100, 29, 6, 1099, 63,
-	1098, 1097, 1096, 1095, 42, 1091, 57, 1090, 34, 65,
-	1088, 1086, 4, 1085, 16, 82, 46, 28, 5, 77,
-	69, 1084, 30, 72, 61, 1083, 1082, 170, 1080, 1079,
-	55, 1078, 1077, 32, 224, 139, 1075, 1070, 1065, 1064,
-	44, 0, 1230, 236, 73, 1062, 1061, 1056, 1775, 48,
-	19, 22, 26, 47, 278, 39, 1055, 1054, 45, 1050,
-	1048, 1042, 1040, 1039, 1038, 1037, 84, 1035, 1034, 1033,
-	36, 20, 1032, 1030, 70, 64, 1028, 1025, 1022, 58,
-	68, 1014, 1013, 59, 43, 1012, 1010, 1008, 1006, 1005,
-	41, 9, 996, 18, 995, 10, 985, 984, 38, 983,
-	14, 982, 8, 979, 11, 975, 2, 56, 3, 973,
-	1, 970, 969, 66, 783, 78, 968, 81,
+var yyPgo = [...]int16{
+	0, 1284, 31, 80, 1282, 220, 83, 161, 110, 954,
+	1279, 1278, 1277, 1274, 1271, 1270, 1266, 1265, 1262, 1261,
+	1260, 1259, 1258, 1257, 1256, 1252, 1251, 1250, 1249, 1248,
+	278, 1247, 1246, 116, 1245, 77, 1244, 81, 1243, 1242,
+	52, 199, 53, 47, 986, 1237, 63, 22, 41, 1236,
+	1234, 59, 30, 24, 29, 1233, 1232, 85, 1231, 1229,
+	61, 1228, 1227, 1519, 1225, 75, 1224, 18, 90, 1223,
+	1221, 1219, 1218, 1217, 1075, 1215, 1214, 21, 1213, 1211,
+	117, 1210, 64, 5, 19, 20, 27, 1209, 79, 13,
+	1205, 62, 1204, 1203, 1202, 1201, 3, 10, 1198, 1197,
+	23, 1196, 1195, 1194, 68, 1193, 44, 70, 1192, 1191,
+	6, 45, 8, 76, 40, 36, 14, 91, 73, 1190,
+	37, 74, 60, 1185, 1183, 283, 1180, 1179, 55, 1178,
+	1177, 38, 294, 281, 1176, 1175, 1174, 1173, 54, 215,
+	1685, 9, 78, 1172, 1171, 1170, 2489, 46, 33, 34,
+	28, 49, 51, 48, 1169, 1167, 43, 1166, 1165, 1164,
+	1163, 1162, 1161, 1159, 65, 1157, 1156, 1155, 35, 25,
+	1151, 1150, 69, 72, 1149, 1148, 1147, 58, 71, 1146,
+	1145, 67, 50, 1144, 1142, 1140, 1136, 1135, 39, 15,
+	1134, 26, 1129, 16, 1126, 1125, 42, 1124, 12, 1123,
+	17, 1115, 7, 1112, 11, 56, 2, 1110, 4, 1108,
+	1105, 0, 919, 82, 1100, 84,
 }
 
-var yyR1 = [...]int{
-	0, 201, 202, 202, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]uint8{
+	0, 209, 210, 210, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 6, 6,
-	8, 8, 7, 9, 3, 4, 5, 5, 10, 10,
-	32, 32, 11, 12, 12, 12, 12, 205, 205, 55,
-	55, 56, 56, 105, 105, 13, 13, 13, 13, 110,
-	110, 114, 114, 114, 115, 115, 115, 115, 146, 146,
-	14, 14, 14, 14, 14, 14, 14, 196, 196, 195,
-	194, 194, 193, 193, 192, 20, 176, 178, 178, 177,
-	177, 177, 177, 170, 149, 149, 149, 149, 152, 152,
-	150, 150, 150, 150, 150, 150, 150, 150, 150, 151,
-	151, 151, 151, 151, 153, 153, 153, 153, 153, 154,
-	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 155, 155, 155, 155, 155, 155,
-	155, 155, 169, 169, 156, 156, 164, 164, 165, 165,
-	165, 162, 162, 163, 163, 166, 166, 166, 158, 158,
-	159, 159, 167, 167, 160, 160, 160, 161, 161, 161,
-	168, 168, 168, 168, 168, 157, 157, 171, 171, 186,
-	186, 185, 185, 185, 175, 175, 182, 182, 182, 182,
-	182, 173, 173, 174, 174, 184, 184, 183, 172, 172,
-	188, 188, 188, 188, 199, 200, 198, 198, 198, 198,
-	198, 179, 179, 179, 180, 180, 180, 181, 181, 181,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 197, 197, 197, 197, 197, 197, 197,
-	197, 197, 197, 197, 191, 189, 189, 190, 190, 16,
-	21, 21, 17, 17, 17, 17, 17, 18, 18, 22,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 121, 121, 119, 119, 122, 122, 120, 120, 120,
-	123, 123, 123, 147, 147, 147, 24, 24, 26, 26,
-	27, 28, 25, 25, 25, 25, 25, 25, 25, 19,
-	206, 29, 30, 30, 31, 31, 31, 35, 35, 35,
-	33, 33, 34, 34, 40, 40, 39, 39, 41, 41,
-	41, 41, 41, 135, 135, 135, 134, 134, 43, 43,
-	44, 44, 45, 45, 46, 46, 46, 46, 46, 64,
-	64, 49, 49, 48, 48, 50, 51, 51, 51, 104,
-	104, 106, 106, 47, 47, 47, 47, 52, 52, 53,
-	53, 54, 54, 142, 142, 141, 141, 141, 187, 187,
-	187, 140, 140, 57, 57, 57, 59, 58, 58, 58,
-	58, 58, 60, 60, 62, 62, 61, 61, 63, 65,
-	65, 65, 65, 66, 66, 42, 42, 42, 42, 42,
-	42, 42, 118, 118, 68, 68, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	79, 79, 79, 79, 79, 79, 69, 69, 69, 69,
-	69, 69, 69, 38, 38, 80, 80, 80, 86, 81,
-	81, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 76, 76, 76, 74, 74, 74,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 2,
+	7, 7, 9, 9, 8, 8, 10, 3, 4, 4,
+	5, 5, 6, 6, 11, 11, 34, 34, 12, 13,
+	13, 13, 13, 213, 213, 57, 57, 58, 58, 113,
+	113, 14, 14, 14, 14, 118, 118, 122, 122, 122,
+	123, 123, 123, 123, 154, 154, 15, 15, 15, 15,
+	15, 15, 15, 204, 204, 203, 202, 202, 201, 201,
+	200, 21, 184, 186, 186, 185, 185, 185, 185, 178,
+	157, 157, 157, 157, 160, 160, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 159, 159, 159, 159, 159,
+	161, 161, 161, 161, 161, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	163, 163, 163, 163, 163, 163, 163, 163, 177, 177,
+	164, 164, 172, 172, 173, 173, 173, 170, 170, 171,
+	171, 174, 174, 174, 166, 166, 167, 167, 175, 175,
+	168, 168, 168, 169, 169, 169, 176, 176, 176, 176,
+	176, 165, 165, 179, 179, 194, 194, 193, 193, 193,
+	183, 183, 190, 190, 190, 190, 190, 181, 181, 182,
+	182, 192, 192, 191, 180, 180, 196, 196, 196, 196,
+	207, 208, 206, 206, 206, 206, 206, 187, 187, 187,
+	188, 188, 188, 189, 189, 189, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	199, 197, 197, 198, 198, 17, 22, 22, 18, 18,
+	18, 18, 18, 19, 19, 23, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 129, 129, 127,
+	127, 130, 130, 128, 128, 128, 131, 131, 131, 155,
+	155, 155, 25, 25, 27, 27, 28, 29, 26, 26,
+	26, 26, 26, 26, 26, 20, 214, 30, 31, 31,
+	32, 32, 32, 32, 32, 32, 33, 33, 33, 37,
+	37, 37, 35, 35, 36, 36, 42, 42, 41, 41,
+	43, 43, 43, 43, 43, 143, 143, 143, 142, 142,
+	45, 45, 46, 46, 47, 47, 48, 48, 48, 48,
+	48, 48, 66, 66, 51, 51, 50, 50, 52, 52,
+	53, 53, 53, 112, 112, 114, 114, 49, 49, 49,
+	49, 54, 54, 55, 55, 56, 56, 150, 150, 149,
+	149, 149, 195, 195, 195, 148, 148, 59, 59, 59,
+	61, 60, 60, 60, 60, 60, 62, 62, 64, 64,
+	63, 63, 65, 67, 67, 67, 67, 68, 68, 44,
+	44, 44, 44, 44, 44, 44, 126, 126, 70, 70,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 81, 81, 81, 81, 81, 81,
+	71, 71, 71, 71, 71, 71, 71, 40, 40, 82,
+	82, 82, 88, 83, 83, 74, 74, 74, 74, 74,
 	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 207, 207, 78, 77,
-	77, 77, 77, 77, 77, 36, 36, 36, 36, 36,
-	145, 145, 148, 148, 148, 148, 90, 90, 37, 37,
-	88, 88, 89, 91, 91, 87, 87, 87, 71, 71,
-	71, 71, 71, 71, 71, 71, 73, 73, 73, 92,
-	92, 93, 93, 94, 94, 95, 95, 96, 97, 97,
-	97, 98, 98, 98, 98, 99, 99, 99, 100, 100,
-	101, 101, 102, 102, 102, 102, 70, 70, 70, 70,
-	70, 70, 103, 103, 103, 103, 107, 107, 82, 82,
-	84, 84, 83, 85, 108, 108, 112, 109, 109, 113,
-	113, 113, 113, 111, 111, 111, 137, 137, 137, 116,
-	116, 124, 124, 125, 125, 117, 117, 126, 126, 126,
-	126, 126, 126, 126, 126, 126, 126, 127, 127, 127,
-	128, 128, 129, 129, 129, 136, 136, 132, 132, 133,
-	133, 138, 138, 139, 139, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	203, 204, 143, 144, 144, 144,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 78, 78,
+	78, 78, 102, 103, 103, 76, 76, 76, 76, 76,
+	76, 76, 76, 76, 76, 76, 76, 76, 76, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 215, 215, 80, 79, 79,
+	79, 79, 79, 79, 38, 38, 38, 38, 38, 153,
+	153, 156, 156, 156, 156, 92, 92, 39, 39, 90,
+	90, 91, 93, 93, 89, 89, 89, 73, 73, 73,
+	73, 73, 73, 73, 73, 75, 75, 75, 94, 94,
+	95, 95, 97, 97, 97, 97, 98, 98, 96, 96,
+	99, 99, 100, 100, 101, 101, 104, 105, 105, 105,
+	106, 106, 106, 106, 107, 107, 107, 108, 108, 109,
+	109, 110, 110, 110, 110, 110, 72, 72, 72, 72,
+	72, 72, 111, 111, 111, 111, 115, 115, 84, 84,
+	86, 86, 85, 87, 116, 116, 120, 117, 117, 121,
+	121, 121, 121, 119, 119, 119, 145, 145, 145, 124,
+	124, 132, 132, 133, 133, 125, 125, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 135, 135, 135,
+	136, 136, 137, 137, 137, 144, 144, 140, 140, 141,
+	141, 146, 146, 147, 147, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 211, 212,
+	151, 152, 152, 152,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 4, 6, 7, 0, 1,
-	1, 3, 5, 5, 11, 3, 1, 3, 7, 8,
-	1, 1, 9, 8, 7, 6, 6, 1, 1, 1,
-	3, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 2, 8, 4, 6, 5, 5, 0, 2, 1,
-	0, 2, 1, 3, 3, 4, 4, 2, 4, 1,
-	3, 3, 3, 8, 3, 1, 1, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
-	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
-	5, 0, 1, 0, 1, 0, 1, 2, 0, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 2,
-	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
-	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
-	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
-	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
-	6, 7, 7, 7, 7, 4, 5, 7, 5, 5,
-	5, 12, 7, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 3, 4, 4, 4, 4, 4, 4, 3, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 2, 2, 2, 2, 3, 3, 5, 2,
-	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 3, 2,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 2, 0, 1, 2, 1, 1, 0, 2,
-	1, 3, 1, 1, 1, 3, 1, 3, 6, 3,
-	7, 0, 1, 1, 3, 3, 1, 4, 4, 1,
-	3, 1, 3, 5, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 0, 1,
-	1, 1, 1, 1, 2, 2, 1, 2, 3, 2,
-	3, 2, 2, 2, 2, 1, 1, 3, 3, 0,
-	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 3, 3, 3, 3, 4, 5, 6, 2,
-	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 0, 2, 1, 1, 1, 3, 1,
-	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 3, 3, 4, 5, 6, 4, 4, 6,
-	6, 6, 8, 8, 8, 8, 9, 7, 5, 4,
+	1, 1, 1, 0, 4, 4, 5, 6, 6, 7,
+	0, 1, 1, 3, 5, 8, 5, 11, 1, 3,
+	1, 3, 1, 3, 7, 8, 1, 1, 9, 8,
+	7, 6, 6, 1, 1, 1, 3, 1, 3, 0,
+	4, 3, 4, 5, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 2, 8, 4,
+	6, 5, 5, 0, 2, 1, 0, 2, 1, 3,
+	3, 4, 4, 2, 4, 1, 3, 3, 3, 8,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 1, 4, 4, 2, 2, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 6, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 0,
+	1, 0, 1, 2, 0, 2, 0, 3, 0, 1,
+	0, 3, 3, 0, 2, 2, 0, 2, 1, 2,
+	1, 0, 2, 5, 4, 1, 2, 2, 3, 2,
+	0, 1, 2, 3, 3, 2, 2, 1, 1, 0,
+	1, 1, 3, 2, 3, 1, 10, 11, 11, 12,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 3,
+	1, 2, 3, 1, 1, 1, 6, 7, 7, 7,
+	7, 4, 5, 7, 5, 5, 5, 12, 7, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	7, 1, 3, 8, 8, 3, 3, 5, 4, 6,
+	5, 4, 4, 3, 2, 3, 4, 3, 4, 4,
+	4, 4, 4, 4, 3, 3, 2, 3, 3, 2,
+	3, 4, 3, 7, 5, 4, 2, 4, 2, 2,
+	2, 2, 3, 3, 5, 2, 3, 1, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	1, 1, 2, 1, 1, 2, 1, 1, 2, 2,
+	2, 2, 2, 3, 3, 2, 0, 2, 0, 2,
+	1, 2, 2, 1, 2, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
+	1, 2, 3, 5, 2, 0, 1, 2, 1, 1,
+	0, 2, 1, 3, 1, 1, 1, 3, 1, 3,
+	6, 6, 3, 7, 0, 1, 1, 3, 3, 3,
+	1, 4, 4, 1, 3, 1, 3, 5, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 0, 1, 1, 1, 1, 1, 2, 2,
+	1, 2, 3, 2, 3, 2, 2, 2, 2, 1,
+	1, 3, 3, 0, 5, 5, 5, 0, 2, 1,
+	3, 3, 2, 3, 1, 2, 0, 3, 1, 1,
+	3, 3, 4, 4, 5, 3, 3, 3, 3, 3,
+	4, 5, 6, 2, 1, 2, 1, 2, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
+	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
+	3, 1, 1, 1, 1, 4, 3, 3, 4, 5,
+	6, 8, 2, 0, 3, 4, 4, 4, 6, 6,
+	6, 8, 8, 8, 8, 9, 7, 5, 4, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 8, 8, 0, 2, 3, 4,
-	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 4, 0, 2,
-	1, 3, 2, 4, 3, 2, 2, 1, 3, 5,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 1,
+	2, 4, 0, 2, 1, 3, 5, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
+	1, 3, 1, 4, 4, 5, 1, 3, 2, 1,
+	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
+	0, 2, 4, 4, 0, 2, 4, 0, 2, 1,
+	3, 2, 4, 3, 3, 2, 2, 1, 3, 5,
 	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
 	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
 	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
@@ -2560,344 +2800,359 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 0, 1, 1,
 }
 
-var yyChk = [...]int{
-	-1000, -201, -1, -2, -9, -10, -11, -12, -13, -14,
-	-15, -16, -17, -18, -22, -23, -24, -26, -27, -28,
-	-25, -19, -3, 279, -4, 6, 7, -32, 9, 10,
-	34, -20, 128, 129, 131, 130, 163, 132, 156, 55,
-	176, 177, 179, 180, 29, 157, 158, 161, 162, 35,
-	36, 134, -203, 8, 266, 59, -202, 283, -94, 15,
-	-8, -7, -140, -138, 64, 62, -131, 23, 276, 149,
-	176, 187, 181, 208, 200, 277, 150, 198, 201, 245,
-	228, 240, 71, 179, 254, 22, 159, 196, 192, 21,
-	190, 31, 242, 88, 213, 281, 191, 241, 134, 152,
-	147, 214, 218, 246, 185, 186, 248, 212, 148, 37,
-	278, 39, 167, 249, 216, 211, 207, 210, 184, 206,
-	43, 220, 219, 221, 244, 203, 153, 193, 89, 18,
-	252, 162, 165, 243, 215, 217, 144, 169, 280, 250,
-	189, 154, 166, 161, 253, 155, 180, 230, 247, 256,
-	42, 225, 183, 146, 177, 173, 231, 204, 168, 194,
-	195, 209, 182, 205, 178, 163, 255, 226, 282, 202,
-	199, 174, 139, 171, 172, 232, 233, 234, 235, 236,
-	237, 175, 20, 251, 197, 227, -31, 5, -29, -206,
-	-29, -29, -29, -29, -29, -176, -178, 59, 98, -129,
-	139, 79, 258, 135, 136, 143, -132, 62, -131, -117,
-	139, 235, 141, 136, 136, 138, 139, 258, 135, 136,
-	-61, -138, 136, 120, 245, 128, 229, 230, 242, 138,
-	37, 243, 169, -147, 136, -119, 228, 232, 233, 234,
-	237, 235, 175, 62, 247, 246, 238, -138, 178, -143,
-	-143, -143, -143, -143, 231, 231, -143, -2, -98, 17,
-	16, -6, 60, 26, -5, -3, -203, 6, 24, 25,
-	-35, 44, 45, -30, -41, 108, -42, -138, -72, -67,
-	81, 33, 62, -131, -71, -68, -87, -85, -86, 120,
-	121, 122, 106, 107, 114, 82, 123, -76, -74, -75,
-	-77, 27, 64, 63, 72, 65, 66, 67, 68, 75,
-	76, 77, -132, -83, -203, 49, 50, 267, 268, 269,
-	270, 275, 271, 84, 38, 257, 265, 264, 263, 261,
-	262, 259, 260, 273, 274, 142, 258, 112, 266, -117,
-	-117, 11, -55, -56, -61, -63, -138, -109, -146, 178,
-	-113, 247, 246, -133, -111, -132, -130, 245, 201, 244,
-	133, 80, 26, 28, 223, 83, 120, 16, 84, 119,
-	267, 128, 53, 259, 260, 257, 269, 270, 258, 229,
-	33, 10, 29, 157, 25, 110, 130, 87, 160, 27,
-	158, 77, 19, 56, 11, 13, 14, 142, 141, 100,
-	138, 51, 8, 123, 30, 97, 46, 32, 49, 98,
-	17, 261, 262, 35, 275, 164, 112, 54, 40, 81,
-	75, 78, 57, 79, 15, 52, 99, 131, 266, 50,
-	135, 6, 272, 34, 156, 47, 136, 86, 273, 274,
-	140, 170, 76, 5, 143, 36, 9, 55, 58, 263,
-	264, 265, 38, 85, 12, 279, -177, 98, -170, 62,
-	-61, 138, -61, 266, -125, 142, -125, -125, 136, -61,
-	128, 130, 133, 57, -21, -61, -124, 142, 62, -124,
-	-124, -124, -61, 124, -61, 62, -144, -203, -133, 258,
-	62, 169, 136, 170, 139, -144, -144, -144, -144, -144,
-	173, 174, -144, -122, -121, 240, 241, 231, 239, 12,
-	231, 172, -144, -143, -143, -204, 61, -99, 19, 35,
-	-42, -72, -138, -95, -96, -42, -2, -7, -203, -94,
-	-2, -29, 40, -33, 25, 70, 11, -135, 80, 79,
-	97, -134, 26, -132, 64, 124, 125, -69, 100, 81,
-	98, 114, 116, 115, 117, 99, 83, 103, 102, 113,
-	106, 107, 108, 109, 110, 111, 112, 104, 105, 119,
-	284, 69, 126, 90, 91, 92, 93, 94, 95, 96,
-	-42, -118, -203, -72, -72, -72, -72, -72, -72, -72,
-	-72, -86, -203, -203, -2, -81, -42, -203, -203, -203,
-	-203, -203, -203, -203, -203, -203, -90, -42, -203, -207,
-	-78, -203, -207, -78, -207, -78, -207, -203, -207, -78,
-	-207, -78, -207, -207, -78, -203, -203, -203, -203, -203,
-	-203, -62, 30, -61, -44, -45, -46, -47, -64, -86,
-	-203, 62, -61, -61, -55, -205, 60, 11, 58, -205,
-	60, 124, 60, -109, 178, -110, -114, 248, 250, 90,
-	-137, -132, 64, 33, 34, 61, 60, -61, -149, -152,
-	-154, -153, -155, -150, -151, 198, 199, 120, 202, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 34,
-	159, 194, 195, 196, 197, 214, 215, 216, 217, 218,
-	219, 220, 221, 181, 200, 277, 182, 183, 184, 185,
-	186, 187, 189, 190, 191, 192, 193, 62, -144, 139,
-	62, 81, 62, -61, -61, -144, 171, 171, 136, 136,
-	-61, 60, 140, -55, 27, 57, -61, 62, 62, -139,
-	-138, -130, -144, -144, -144, -144, -61, -144, -144, -144,
-	-144, 11, -120, 11, 100, -42, 57, 9, 100, 60,
-	18, 124, 60, -97, 28, 29, -2, -98, -204, -35,
-	-73, -132, 65, 68, -34, 47, -61, -42, -42, -79,
-	75, 81, 76, 77, -134, 108, -139, -133, -130, -72,
-	-80, -83, -86, 69, 100, 98, 99, 83, -72, -72,
-	-72, -72, -72, -72, -72, -72, -72, -72, -72, -72,
-	-72, -72, -72, -72, -72, -72, -72, -145, 62, 64,
-	-72, -148, 62, -131, 73, 74, -132, 62, -132, -40,
-	25, -39, -41, -204, 60, -204, -2, -39, -39, -42,
-	-42, -87, 64, -39, -87, 64, -39, -39, -33, -88,
-	-89, 85, -87, -132, -138, -204, -72, -132, -132, -39,
-	-40, -39, -39, -105, 165, -61, 34, 60, -187, -59,
-	-58, -60, 48, 7, 47, 49, 50, 52, 54, -142,
-	26, -44, -203, -203, -141, 165, -140, 26, -105, 58,
-	-44, -61, -44, -63, -138, 108, -113, -110, 60, 249,
-	251, 252, 57, 78, -42, -161, 119, -179, -180, -181,
-	-133, 64, 65, -170, -171, -172, -182, 151, -188, 144,
-	146, 143, -173, 152, 138, 32, 61, -166, 75, 81,
-	-162, 226, -156, 59, -156, -156, -156, -156, -160, 201,
-	-160, -160, -160, 59, 59, -156, -156, -156, -164, 59,
-	-164, -164, -165, 59, -165, -136, 58, -61, -144, 27,
-	-144, -126, 133, 130, 131, -191, 129, 223, 201, 71,
-	33, 15, 267, 165, 282, 62, 166, -132, -132, -61,
-	-61, 133, 130, -61, -61, -61, -144, -61, -123, 98,
-	12, -138, -138, -61, 42, -42, -42, -139, -96, -204,
-	-99, -116, 19, 11, 38, 38, -39, 75, 76, 77,
-	124, -203, -80, -72, -72, -72, -38, 160, 80, 285,
-	-204, -204, -39, 60, -42, -204, -204, -204, 60, 58,
-	26, 11, 11, -204, 11, 11, -204, -204, -39, -91,
-	-89, 87, -42, -204, 124, -204, 60, 60, -204, -204,
-	-204, -204, -70, 34, 38, -2, -203, -203, -108, -112,
-	-87, -45, -57, 46, 51, 53, -46, -45, -46, 46,
-	52, 46, 52, 46, 46, -58, -138, -204, -49, -48,
-	-50, -132, -65, 55, 141, 56, -203, -140, -66, 12,
-	-44, -66, -66, 124, -114, -115, 253, 250, 256, 62,
-	64, 60, -181, 90, 59, 62, 32, -173, -173, -174,
-	62, -174, 32, -158, 33, 75, -163, 227, 65, -160,
-	-160, -161, 34, -161, -161, -161, -169, 64, -169, 65,
-	65, 57, -132, -144, -143, -197, 145, 151, 152, 147,
-	62, 138, 32, 144, 146, 165, 143, -197, -127, -128,
-	140, 26, 138, 32, 165, -196, 58, 171, 171, 140,
-	-144, -120, 64, -42, 43, 124, -61, -43, 11, 108,
-	-133, -40, -38, 80, -72, -72, -204, -41, -148, -145,
-	-148, -72, -72, -72, -72, 276, -94, 88, -42, 86,
-	-133, -72, -72, -107, 57, -108, -82, -84, -83, -203,
-	-2, -103, -132, -106, -132, -66, 60, 90, -46, 46,
-	46, -54, 57, -52, 57, 58, 46, 46, -204, 60,
-	101, 138, 138, 138, -106, -94, -42, -66, 250, 254,
-	255, -180, -181, -184, -183, -132, -188, -174, -174, 59,
-	-159, 57, -72, 61, -161, -161, 62, 120, 61, 60,
-	61, 60, 61, 60, -61, -143, -143, -61, -143, -132,
-	-194, 279, -195, 62, -132, -132, -61, -123, -66, -44,
-	-204, -72, -204, -204, -204, 19, 19, 19, 19, -203,
-	-37, 272, -42, 60, 60, 31, -107, 60, -204, -204,
-	-204, 60, 124, -204, 60, -94, -112, -42, -53, -52,
-	-42, -42, 59, -142, -50, -51, -42, 136, 137, -203,
-	-203, -203, -204, -98, 61, 60, -156, -104, -132, -167,
-	223, 9, -160, 64, -160, 65, 65, -144, 30, -193,
-	-192, -133, 59, -92, 13, -72, -72, -72, -72, -72,
-	-204, 64, -72, -72, 32, -84, 38, -2, -203, -132,
-	-132, -132, -98, -104, -138, -203, -203, -104, -104, -104,
-	-141, -186, -185, 58, 148, 71, -183, 61, 60, -168,
-	144, 32, 143, -75, -161, -161, 61, 61, -203, 60,
-	90, -104, -93, 14, 16, -204, -204, -204, -204, -36,
-	100, 279, -204, -204, 9, -82, -2, 124, 61, -45,
-	-87, -204, -204, -204, -65, -185, 62, -175, 90, 64,
-	154, -132, -157, 71, 32, 32, -189, -190, 165, -192,
-	-181, 61, -100, 170, -42, -81, -204, 277, 54, 280,
-	-108, -204, -132, -204, -204, 65, -61, 64, -204, 60,
-	-132, -196, -101, -102, 57, 23, 22, 43, 278, 281,
-	59, -190, 38, -194, 60, 20, 88, 21, -42, 43,
-	-104, 167, -102, 89, -42, 279, 61, 168, 7, 280,
-	-199, -200, 57, -203, 281, -200, 57, 10, 9, -72,
-	164, -198, 155, 150, 153, 34, -198, -204, -204, 149,
-	33, 75,
+var yyChk = [...]int16{
+	-1000, -209, -1, -2, -10, -11, -12, -13, -14, -15,
+	-16, -17, -18, -19, -23, -24, -25, -27, -28, -29,
+	-26, -20, -3, 287, -4, -5, 8, 9, -34, 11,
+	12, 42, -21, 136, 137, 139, 138, 171, 140, 164,
+	63, 184, 185, 187, 188, 37, 165, 166, 169, 170,
+	43, 44, 142, -6, 10, 274, -211, 67, -210, 291,
+	-100, 17, -9, 27, -8, -148, -146, 72, 70, -139,
+	25, 284, 157, 184, 195, 189, 216, 208, 285, 158,
+	206, 209, 253, 236, 248, 79, 187, 262, 24, 167,
+	204, 200, 23, 198, 39, 250, 96, 221, 289, 199,
+	249, 142, 160, 155, 222, 226, 254, 193, 194, 256,
+	220, 156, 45, 286, 47, 175, 257, 224, 219, 215,
+	218, 192, 214, 51, 228, 227, 229, 252, 211, 161,
+	201, 97, 20, 260, 170, 173, 251, 223, 225, 152,
+	177, 288, 258, 197, 162, 174, 169, 261, 163, 188,
+	238, 255, 31, 264, 50, 233, 191, 154, 185, 181,
+	239, 212, 176, 202, 203, 217, 190, 213, 186, 171,
+	263, 234, 290, 210, 207, 182, 147, 179, 180, 240,
+	241, 242, 243, 244, 245, 183, 22, 259, 205, 235,
+	-32, 5, 6, -33, 7, -30, -214, -30, -30, -30,
+	-30, -30, -184, -186, 67, 106, -137, 147, 87, 266,
+	143, 144, 151, -140, 70, -139, -125, 147, 243, 149,
+	144, 144, 146, 147, 266, 143, 144, -63, -146, 144,
+	128, 253, 136, 237, 238, 250, 146, 45, 251, 177,
+	-155, 144, -127, 236, 240, 241, 242, 245, 243, 183,
+	70, 255, 254, 246, -146, 186, -151, -151, -151, -151,
+	-151, 239, 239, -151, -2, -106, 19, 18, -7, 68,
+	-9, 34, -211, -5, -3, 8, 32, 33, 32, 33,
+	-6, 32, 33, -37, 52, 53, -31, -43, 116, -44,
+	-146, -74, -69, 89, 41, 70, -139, -73, -70, -89,
+	-87, -88, 128, 129, 130, 114, 115, 122, 90, 131,
+	-78, -76, -77, -79, 35, 72, 71, 80, 73, 74,
+	75, 76, 83, 84, 85, -140, -85, -211, 57, 58,
+	30, 275, 276, 277, 278, 283, 279, 92, 46, 265,
+	273, 272, 271, 269, 270, 267, 268, 281, 282, 150,
+	266, 120, 274, -125, -125, 13, -57, -58, -63, -65,
+	-146, -117, -154, 186, -121, 255, 254, -141, -119, -140,
+	-138, 253, 209, 252, 141, 88, 34, 36, 231, 91,
+	128, 18, 92, 127, 275, 136, 61, 29, 267, 268,
+	265, 277, 278, 266, 237, 41, 12, 37, 165, 33,
+	118, 138, 95, 168, 6, 35, 166, 85, 21, 64,
+	13, 15, 30, 16, 150, 149, 108, 146, 59, 10,
+	7, 131, 38, 105, 54, 40, 57, 106, 19, 269,
+	270, 43, 283, 172, 120, 62, 48, 89, 83, 86,
+	65, 87, 17, 60, 26, 27, 107, 139, 274, 58,
+	28, 143, 8, 280, 42, 164, 55, 144, 94, 281,
+	282, 148, 178, 84, 5, 151, 44, 11, 63, 66,
+	271, 272, 273, 46, 93, 14, 287, -185, 106, -178,
+	70, -63, 146, -63, 274, -133, 150, -133, -133, 144,
+	-63, 136, 138, 141, 65, -22, -63, -132, 150, 70,
+	-132, -132, -132, -63, 132, -63, 70, -152, -211, -141,
+	266, 70, 177, 144, 178, 147, -152, -152, -152, -152,
+	-152, 181, 182, -152, -130, -129, 248, 249, 239, 247,
+	14, 239, 180, -152, -151, -151, -212, 69, -107, 21,
+	43, -44, -74, -146, -101, -104, -44, -2, -8, -7,
+	-211, -111, -140, -100, -33, -30, -100, 48, -35, 33,
+	78, 13, -143, 88, 87, 105, -142, 34, -140, 72,
+	132, 133, -71, 108, 89, 106, 122, 124, 123, 125,
+	107, 91, 111, 110, 121, 114, 115, 116, 117, 118,
+	119, 120, 112, 113, 127, 292, 77, 134, 98, 99,
+	100, 101, 102, 103, 104, -44, -126, -211, -74, -74,
+	-74, -74, -74, -74, -74, -74, -88, -211, -211, -2,
+	-83, -44, -211, -211, -211, -211, -211, -211, -211, -211,
+	-211, -211, -92, -44, -211, -215, -80, -211, -215, -80,
+	-215, -80, -215, -211, -215, -80, -215, -80, -215, -215,
+	-80, -211, -211, -211, -211, -211, -211, -64, 38, -63,
+	-46, -47, -48, -49, -66, -88, -211, 70, 255, -63,
+	-63, -57, -213, 68, 13, 66, -213, 68, 132, 68,
+	-117, 186, -118, -122, 256, 258, 98, -145, -140, 72,
+	41, 42, 69, 68, -63, -157, -160, -162, -161, -163,
+	-158, -159, 206, 207, 128, 210, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 42, 167, 202, 203,
+	204, 205, 222, 223, 224, 225, 226, 227, 228, 229,
+	189, 208, 285, 190, 191, 192, 193, 194, 195, 197,
+	198, 199, 200, 201, 70, -152, 147, 70, 89, 70,
+	-63, -63, -152, 179, 179, 144, 144, -63, 68, 148,
+	-57, 35, 65, -63, 70, 70, -147, -146, -138, -152,
+	-152, -152, -152, -63, -152, -152, -152, -152, 13, -128,
+	13, 108, -44, 65, 11, 108, 68, 20, 132, 68,
+	-105, 36, 37, -2, -2, -212, 68, 132, -106, -6,
+	-37, -106, -75, -140, 73, 76, -36, 55, -63, -44,
+	-44, -81, 83, 89, 84, 85, -142, 116, -147, -141,
+	-138, -74, -82, -85, -88, 77, 108, 106, 107, 91,
+	-74, -74, -74, -74, -74, -74, -74, -74, -74, -74,
+	-74, -74, -74, -74, -74, -74, -74, -74, -74, -153,
+	70, 72, -74, -156, 70, -139, 81, 82, -140, 70,
+	-140, -42, 33, -41, -43, -212, 68, -212, -2, -41,
+	-41, -41, -44, -44, -89, 72, -41, -89, 72, -41,
+	-41, -35, -90, -91, 93, -89, -140, -146, -212, -74,
+	-140, -140, -41, -42, -41, -41, -113, 173, -63, 42,
+	68, -195, -61, -60, -62, 56, 9, 55, 57, 58,
+	60, 62, -150, 34, -46, -211, -211, -211, -149, 173,
+	-148, 34, -113, 66, -46, -63, -46, -65, -146, 116,
+	-121, -118, 68, 257, 259, 260, 65, 86, -44, -169,
+	127, -187, -188, -189, -141, 72, 73, -178, -179, -180,
+	-190, 159, -196, 152, 154, 151, -181, 160, 146, 40,
+	69, -174, 83, 89, -170, 234, -164, 67, -164, -164,
+	-164, -164, -168, 209, -168, -168, -168, 67, 67, -164,
+	-164, -164, -172, 67, -172, -172, -173, 67, -173, -144,
+	66, -63, -152, 35, -152, -134, 141, 138, 139, -199,
+	137, 231, 209, 79, 41, 17, 275, 173, 290, 70,
+	174, -140, -140, -63, -63, 141, 138, -63, -63, -63,
+	-152, -63, -131, 106, 14, -146, -146, -63, 50, -44,
+	-44, -147, -104, -212, 34, -140, -140, -107, -107, -124,
+	21, 13, 46, 46, -41, 83, 84, 85, 132, -211,
+	-82, -74, -74, -74, -40, 168, 88, 293, -212, -212,
+	-41, 68, -44, -212, -212, -212, -212, 68, 66, 34,
+	13, 13, -212, 13, 13, -212, -212, -41, -93, -91,
+	95, -44, -212, 132, -212, 68, 68, -212, -212, -212,
+	-212, -72, 42, 46, -2, -211, -211, -116, -120, -89,
+	-47, -59, 54, 59, 61, -48, -47, -48, 54, 60,
+	54, 60, 54, 54, -60, -146, -212, -51, -50, -52,
+	-140, 40, -51, -67, 63, 149, 64, -211, -148, -68,
+	14, -46, -68, -68, 132, -122, -123, 261, 258, 264,
+	70, 72, 68, -189, 98, 67, 70, 40, -181, -181,
+	-182, 70, -182, 40, -166, 41, 83, -171, 235, 73,
+	-168, -168, -169, 42, -169, -169, -169, -177, 72, -177,
+	73, 73, 65, -140, -152, -151, -205, 153, 159, 160,
+	155, 70, 146, 40, 152, 154, 173, 151, -205, -135,
+	-136, 148, 34, 146, 40, 173, -204, 66, 179, 179,
+	148, -152, -128, 72, -44, 51, 132, -211, 132, -63,
+	-45, 13, 116, -141, -42, -40, 88, -74, -74, 26,
+	-212, -43, -156, -153, -156, -74, -74, -74, -74, 284,
+	-100, 96, -44, 94, -141, -74, -74, -115, 65, -116,
+	-84, -86, -85, -211, -2, -111, -114, -140, -68, 68,
+	98, -48, 54, 54, -56, 65, -54, 65, 66, 54,
+	54, -212, 68, 109, 109, -212, 146, 146, 146, -114,
+	-100, -44, -68, 258, 262, 263, -188, -189, -192, -191,
+	-140, -196, -182, -182, 67, -167, 65, -74, 69, -169,
+	-169, 70, 128, 69, 68, 69, 68, 69, 68, -63,
+	-151, -151, -63, -151, -140, -202, 287, -203, 70, -140,
+	-140, -63, -131, -2, -140, -68, -46, -212, -74, -211,
+	-212, -212, -212, 21, 21, 21, 21, -211, -39, 280,
+	-44, 68, 68, 39, -115, 68, -212, -212, -212, -212,
+	68, -100, -120, -44, -55, -54, -44, -44, 67, -150,
+	-52, -53, -44, 144, 145, -53, -150, -211, -211, -211,
+	-212, -106, 69, 68, -164, -112, -140, -175, 231, 11,
+	-168, 72, -168, 73, 73, -152, 38, -201, -200, -141,
+	67, -212, -94, 15, -102, -103, 173, -74, -74, -74,
+	-74, -74, -212, 72, -74, -74, 40, -86, 46, -2,
+	-211, -140, -106, -112, -146, -211, -211, -146, -112, -112,
+	-112, -149, -194, -193, 66, 156, 79, -191, 69, 68,
+	-176, 152, 40, 151, -77, -169, -169, 69, 69, -211,
+	68, 98, -112, -99, 16, 18, -212, -100, 18, -212,
+	-212, -212, -212, -38, 108, 287, -212, -212, 11, -84,
+	-2, 69, -47, -89, -212, -212, -212, -67, -193, 70,
+	-183, 98, 72, 162, -140, -165, 79, 40, 40, -197,
+	-198, 173, -200, -189, 69, -108, 178, -44, -95, -97,
+	-44, 28, 29, 30, -83, -212, 285, 62, 288, -116,
+	-212, -212, -212, 73, -63, 72, -212, 68, -140, -204,
+	-109, -110, 65, 25, 24, 68, -211, -211, 31, 51,
+	286, 289, 67, -198, 46, -202, 68, 22, 96, 23,
+	23, -44, -97, -83, -83, -211, 51, -112, 175, -110,
+	97, -44, -44, -212, -212, -98, -96, -211, -44, 287,
+	69, 176, 9, -212, 68, -212, 288, -207, -208, 65,
+	-211, -96, 289, -208, 65, 12, 11, -74, 172, -206,
+	163, 158, 161, 42, -206, -212, -212, 157, 41, 83,
 }
 
-var yyDef = [...]int{
+var yyDef = [...]int16{
 	23, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 573, 0, 0, 320, 320, 320, 320, 320,
-	320, 0, 652, 635, 0, 0, 0, 0, -2, 307,
-	308, 0, 310, 311, 882, 882, 882, 882, 882, 0,
-	0, 882, 0, 40, 41, 880, 1, 3, 581, 0,
-	28, 30, 0, 391, 392, 661, 662, 761, 762, 763,
-	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 823,
-	824, 825, 826, 827, 828, 829, 830, 831, 832, 833,
-	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
-	844, 845, 846, 847, 848, 849, 850, 851, 852, 853,
-	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
-	864, 865, 866, 867, 868, 869, 870, 871, 872, 873,
-	874, 875, 876, 877, 878, 879, 0, 324, 327, 322,
-	0, 635, 635, 0, 0, 70, 71, 0, 0, 0,
-	866, 0, 633, 633, 633, 653, 654, 657, 658, 0,
-	0, 0, 636, 0, 631, 0, 631, 631, 631, 0,
-	258, 406, 0, 0, 883, 0, 883, 883, 270, 883,
-	883, 273, 883, 0, 883, 0, 280, 282, 283, 284,
-	285, 0, 289, 883, 304, 305, 294, 306, 309, 312,
-	313, 314, 315, 316, 882, 882, 319, 0, 585, 0,
-	0, 0, 29, 0, 573, 36, 0, 320, 325, 326,
-	330, 328, 329, 321, 0, 338, 343, 0, 420, 415,
-	0, 422, -2, -2, 461, 462, 463, 464, 465, 0,
-	0, 0, 0, 0, 0, 0, 0, 487, 488, 489,
-	490, 0, 558, 559, 560, 561, 562, 563, 564, 565,
-	424, 425, 555, 613, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 546, 0, 526, 526, 526, 526, 526,
-	526, 526, 526, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 49, 51, 406, 55, 0, 858,
-	617, -2, -2, 0, 0, 659, 660, -2, 772, -2,
-	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 677, 678, 679, 680, 681, 682, 683, 684,
-	685, 686, 687, 688, 689, 690, 691, 692, 693, 694,
-	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
-	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
-	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
-	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 738, 739, 740, 741, 742, 743, 744,
-	745, 746, 747, 748, 749, 750, 751, 752, 753, 754,
-	755, 756, 757, 758, 759, 760, 0, 0, 89, 0,
-	87, 0, 883, 0, 0, 0, 0, 0, 0, 883,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 259, 883, 261, 884, 885, 883,
-	883, 883, 0, 883, 883, 268, 269, 271, 272, 274,
-	883, 883, 276, 0, 297, 295, 296, 291, 292, 0,
-	286, 287, 290, 317, 318, 35, 881, 24, 0, 0,
-	582, 420, 0, 574, 575, 578, 25, 31, 0, 581,
-	0, 327, 0, 332, 331, 323, 0, 339, 0, 0,
-	0, 344, 0, 346, 347, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 446, 447, 448, 449, 450, 451, 452,
-	418, 421, 0, 479, 480, 481, 482, 483, 484, 485,
-	0, 439, 0, 334, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 0, 330, 0, 547, 0, 510,
-	518, 0, 511, 519, 512, 520, 513, 0, 514, 521,
-	515, 522, 516, 517, 523, 0, 0, 0, 334, 0,
-	0, 53, 0, 405, 0, -2, 352, 353, 354, -2,
-	0, 661, 385, -2, 0, 0, 0, 47, 48, 0,
-	0, 0, 0, 56, 858, 58, 59, 0, 0, 0,
-	167, 626, 627, 628, 624, 211, 0, 0, 155, 151,
-	95, 96, 97, 144, 99, 144, 144, 144, 144, 164,
-	164, 164, 164, 127, 128, 129, 130, 131, 0, 0,
-	114, 144, 144, 144, 118, 134, 135, 136, 137, 138,
-	139, 140, 141, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 146, 146, 146, 148, 148, 655, 73, 0,
-	883, 0, 883, 85, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 252, 632, 0, 883, 255, 256, 407,
-	663, 664, 260, 262, 263, 264, 265, 266, 267, 275,
-	279, 0, 300, 0, 0, 281, 0, 586, 0, 0,
-	0, 0, 0, 577, 579, 580, 0, 585, 37, 330,
-	0, 566, 0, 0, 0, 333, 33, 416, 417, 419,
-	440, 0, 442, 444, 345, 340, 0, 556, -2, 426,
-	427, 455, 456, 457, 0, 0, 0, 0, 453, 431,
-	432, 433, 434, 435, 0, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 540, 541,
-	0, 492, 542, 543, 544, 545, 493, 0, 486, 0,
-	0, 335, 336, 458, 0, 612, 0, 0, 0, 0,
-	0, 463, 558, 0, 463, 558, 0, 0, 0, 553,
-	550, 0, 0, 555, 0, 527, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 404, 0, 0, 0, 0,
-	0, 0, 389, 390, 396, 0, 0, 0, 0, 0,
-	384, 0, 0, 361, 409, 826, 386, 0, 413, 0,
-	413, 50, 413, 52, 0, 408, 618, 57, 0, 0,
-	62, 63, 619, 620, 621, 622, 0, 86, 212, 214,
-	217, 218, 219, 90, 91, 92, 0, 0, 199, 0,
-	0, 193, 193, 0, 191, 192, 88, 158, 156, 0,
-	153, 152, 98, 0, 164, 164, 121, 122, 167, 0,
-	167, 167, 167, 0, 0, 115, 116, 117, 109, 0,
-	110, 111, 112, 0, 113, 0, 0, 883, 75, 634,
-	76, 882, 0, 0, 647, 226, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 0, 77, 228, 230,
-	229, 0, 0, 0, 250, 883, 254, 297, 278, 0,
-	0, 298, 299, 288, 0, 583, 584, 0, 576, 32,
-	26, 0, 629, 630, 567, 568, 348, 441, 443, 445,
-	0, 334, 428, 453, 436, 0, 429, 0, 0, 491,
-	423, 494, 0, 0, 460, -2, 497, 498, 0, 0,
-	0, 0, 0, 533, 0, 0, 534, 0, 573, 0,
-	551, 0, 0, 509, 0, 528, 0, 0, 529, 530,
-	531, 532, 606, 0, 0, 597, 0, 0, 413, 614,
-	0, -2, 0, 393, 0, 0, 381, 388, 376, 397,
-	0, 399, 0, 401, 402, 403, 355, 357, 0, 362,
-	363, 0, 359, 0, 0, 0, 0, 387, 573, 0,
-	413, 45, 46, 0, 60, 61, 0, 0, 67, 168,
-	169, 0, 215, 0, 0, 0, 186, 193, 193, 189,
-	194, 190, 0, 160, 0, 157, 94, 154, 0, 167,
-	167, 123, 0, 124, 125, 126, 0, 142, 0, 0,
-	0, 0, 656, 74, 220, 882, 233, 234, 235, 236,
-	237, 238, 239, 240, 241, 242, 243, 882, 0, 882,
-	648, 649, 650, 651, 0, 80, 0, 0, 0, 0,
-	253, 300, 301, 302, 587, 0, 27, 413, 0, 341,
-	557, 0, 430, 0, 454, 437, 495, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 548, 508, 554, 0,
-	556, 0, 0, 38, 0, 606, 596, 608, 610, 0,
-	0, 0, 602, 0, 371, 573, 0, 0, 379, 394,
-	395, 374, 0, 375, 0, 0, 398, 400, 383, 0,
-	0, 0, 0, 0, 0, 581, 414, 44, 64, 65,
-	66, 213, 216, 0, 195, 144, 198, 187, 188, 0,
-	162, 0, 159, 145, 119, 120, 165, 166, 164, 0,
-	164, 0, 149, 0, 883, 221, 222, 223, 224, 0,
-	227, 0, 78, 79, 0, 232, 251, 277, 569, 349,
-	496, 438, 499, 501, 500, 0, 0, 0, 0, 0,
-	0, 0, 552, 0, 0, 0, 39, 0, 611, -2,
-	0, 0, 0, 54, 0, 581, 615, 616, 373, 380,
-	382, 377, 0, 0, 364, 365, 366, 0, 0, 0,
-	0, 0, 385, 43, 178, 0, 197, 0, 369, 170,
-	163, 0, 167, 143, 167, 0, 0, 72, 0, 81,
-	82, 0, 0, 571, 0, 0, 0, 0, 0, 535,
-	507, 549, 0, 0, 0, 609, 0, 600, 0, 604,
-	603, 372, 42, 0, 358, 0, 0, 0, 0, 0,
-	409, 177, 179, 0, 184, 0, 196, 0, 0, 175,
-	0, 172, 174, 161, 132, 133, 147, 150, 0, 0,
-	0, 0, 588, 0, 0, 502, 504, 503, 505, 0,
-	0, 0, 524, 525, 0, 599, 0, 0, 378, 388,
-	0, 410, 411, 412, 360, 180, 181, 0, 185, 183,
-	0, 370, 93, 0, 171, 173, 0, 245, 0, 83,
-	84, 77, 34, 0, 572, 570, 506, 0, 0, 0,
-	607, -2, 605, 367, 368, 182, 0, 176, 244, 0,
-	0, 80, 589, 590, 0, 0, 0, 536, 0, 539,
-	0, 246, 0, 231, 0, 592, 0, 0, 595, 537,
-	0, 0, 591, 0, 594, 0, 200, 0, 593, 0,
-	201, 202, 0, 0, 538, 203, 0, 0, 0, 0,
-	0, 204, 206, 207, 0, 0, 205, 247, 248, 208,
-	209, 210,
+	21, 22, -2, 0, 0, 38, 326, 326, 326, 326,
+	326, 326, 0, 682, 665, 0, 0, 0, 0, -2,
+	313, 314, 0, 316, 317, 920, 920, 920, 920, 920,
+	0, 0, 920, 40, 46, 47, 0, 918, 1, 3,
+	610, 0, 30, 0, 32, 0, 405, 406, 691, 692,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
+	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
+	888, 889, 890, 891, 892, 893, 894, 895, 896, 897,
+	898, 899, 900, 901, 902, 903, 904, 905, 906, 907,
+	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
+	0, 330, 333, 0, 336, 339, 328, 0, 665, 665,
+	0, 0, 76, 77, 0, 0, 0, 904, 0, 663,
+	663, 663, 683, 684, 687, 688, 0, 0, 0, 666,
+	0, 661, 0, 661, 661, 661, 0, 264, 420, 0,
+	0, 921, 0, 921, 921, 276, 921, 921, 279, 921,
+	0, 921, 0, 286, 288, 289, 290, 291, 0, 295,
+	921, 310, 311, 300, 312, 315, 318, 319, 320, 321,
+	322, 920, 920, 325, 0, 614, 0, 0, 0, 31,
+	30, 0, 0, -2, 42, 326, 331, 332, 334, 335,
+	-2, 337, 338, 342, 340, 341, 327, 0, 350, 355,
+	0, 434, 429, 0, 436, -2, -2, 475, 476, 477,
+	478, 479, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 502, 503, 504, 0, 577, 578, 579, 580, 581,
+	582, 583, 584, 438, 439, 574, 643, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 565, 0, 545,
+	545, 545, 545, 545, 545, 545, 545, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 55, 57,
+	420, 61, 0, 896, 647, -2, -2, 0, 0, 689,
+	690, -2, 809, -2, 695, 696, 697, 698, 699, 700,
+	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
+	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
+	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
+	731, 732, 733, 734, 735, 736, 737, 738, 739, 740,
+	741, 742, 743, 744, 745, 746, 747, 748, 749, 750,
+	751, 752, 753, 754, 755, 756, 757, 758, 759, 760,
+	761, 762, 763, 764, 765, 766, 767, 768, 769, 770,
+	771, 772, 773, 774, 775, 776, 777, 778, 779, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 0, 0, 95,
+	0, 93, 0, 921, 0, 0, 0, 0, 0, 0,
+	921, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 265, 921, 267, 922, 923,
+	921, 921, 921, 0, 921, 921, 274, 275, 277, 278,
+	280, 921, 921, 282, 0, 303, 301, 302, 297, 298,
+	0, 292, 293, 296, 323, 324, 43, 919, 24, 0,
+	0, 611, 434, 0, 603, 604, 607, 25, 33, 0,
+	0, 0, 632, 610, 0, 339, 610, 0, 344, 343,
+	329, 0, 351, 0, 0, 0, 356, 0, 358, 359,
+	0, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 460, 461,
+	462, 463, 464, 465, 466, 432, 435, 0, 493, 494,
+	495, 496, 497, 498, 499, 0, 453, 0, 346, 0,
+	0, 473, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 0, 566, 0, 529, 537, 0, 530, 538,
+	531, 539, 532, 0, 533, 540, 534, 541, 535, 536,
+	542, 0, 0, 0, 346, 0, 0, 59, 0, 419,
+	0, -2, 364, 365, 366, -2, 0, 691, 879, 399,
+	-2, 0, 0, 0, 53, 54, 0, 0, 0, 0,
+	62, 896, 64, 65, 0, 0, 0, 173, 656, 657,
+	658, 654, 217, 0, 0, 161, 157, 101, 102, 103,
+	150, 105, 150, 150, 150, 150, 170, 170, 170, 170,
+	133, 134, 135, 136, 137, 0, 0, 120, 150, 150,
+	150, 124, 140, 141, 142, 143, 144, 145, 146, 147,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 152,
+	152, 152, 154, 154, 685, 79, 0, 921, 0, 921,
+	91, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	258, 662, 0, 921, 261, 262, 421, 693, 694, 266,
+	268, 269, 270, 271, 272, 273, 281, 285, 0, 306,
+	0, 0, 287, 0, 615, 0, 0, 0, 0, 0,
+	606, 608, 609, 26, 0, 0, 0, 0, 614, 41,
+	342, 614, 0, 585, 0, 0, 0, 345, 36, 430,
+	431, 433, 454, 0, 456, 458, 357, 352, 0, 575,
+	-2, 440, 441, 469, 470, 471, 0, 0, 0, 0,
+	467, 445, 446, 447, 448, 449, 0, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	559, 560, 0, 506, 561, 562, 563, 564, 507, 0,
+	500, 0, 0, 347, 348, 472, 0, 642, 0, 0,
+	0, 0, 0, 0, 477, 577, 0, 477, 577, 0,
+	0, 0, 572, 569, 0, 0, 574, 0, 546, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 418, 0,
+	0, 0, 0, 0, 0, 403, 404, 410, 0, 0,
+	0, 0, 0, 398, 0, 0, 374, 374, 423, 863,
+	400, 0, 427, 0, 427, 56, 427, 58, 0, 422,
+	648, 63, 0, 0, 68, 69, 649, 650, 651, 652,
+	0, 92, 218, 220, 223, 224, 225, 96, 97, 98,
+	0, 0, 205, 0, 0, 199, 199, 0, 197, 198,
+	94, 164, 162, 0, 159, 158, 104, 0, 170, 170,
+	127, 128, 173, 0, 173, 173, 173, 0, 0, 121,
+	122, 123, 115, 0, 116, 117, 118, 0, 119, 0,
+	0, 921, 81, 664, 82, 920, 0, 0, 677, 232,
+	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
+	0, 83, 234, 236, 235, 0, 0, 0, 256, 921,
+	260, 303, 284, 0, 0, 304, 305, 294, 0, 612,
+	613, 0, 605, 34, 0, 634, 633, 27, 28, 0,
+	659, 660, 586, 587, 360, 455, 457, 459, 0, 346,
+	442, 467, 450, 0, 443, 0, 0, 505, 437, 508,
+	0, 0, 474, -2, 515, 516, 517, 0, 0, 0,
+	0, 0, 552, 0, 0, 553, 0, 602, 0, 570,
+	0, 0, 528, 0, 547, 0, 0, 548, 549, 550,
+	551, 636, 0, 0, 627, 0, 0, 427, 644, 0,
+	-2, 0, 407, 0, 0, 395, 402, 390, 411, 0,
+	413, 0, 415, 416, 417, 367, 369, 0, 375, 376,
+	0, 0, 0, 372, 0, 0, 0, 0, 401, 602,
+	0, 427, 51, 52, 0, 66, 67, 0, 0, 73,
+	174, 175, 0, 221, 0, 0, 0, 192, 199, 199,
+	195, 200, 196, 0, 166, 0, 163, 100, 160, 0,
+	173, 173, 129, 0, 130, 131, 132, 0, 148, 0,
+	0, 0, 0, 686, 80, 226, 920, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 920, 0,
+	920, 678, 679, 680, 681, 0, 86, 0, 0, 0,
+	0, 259, 306, 307, 308, 616, 0, 0, 0, 29,
+	427, 0, 353, 576, 0, 444, 0, 468, 451, 0,
+	509, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	567, 527, 573, 0, 575, 0, 0, 44, 0, 636,
+	626, 638, 640, 0, 0, 0, 0, 385, 602, 0,
+	0, 393, 408, 409, 388, 0, 389, 0, 0, 412,
+	414, 397, 0, 0, 0, 397, 0, 0, 0, 0,
+	610, 428, 50, 70, 71, 72, 219, 222, 0, 201,
+	150, 204, 193, 194, 0, 168, 0, 165, 151, 125,
+	126, 171, 172, 170, 0, 170, 0, 155, 0, 921,
+	227, 228, 229, 230, 0, 233, 0, 84, 85, 0,
+	238, 257, 283, 0, 635, 588, 361, 510, 452, 513,
+	518, 520, 519, 0, 0, 0, 0, 0, 0, 0,
+	571, 0, 0, 0, 45, 0, 641, -2, 0, 60,
+	0, 610, 645, 646, 387, 394, 396, 391, 0, 0,
+	377, 378, 380, 0, 0, 379, 0, 0, 0, 0,
+	399, 49, 184, 0, 203, 0, 383, 176, 169, 0,
+	173, 149, 173, 0, 0, 78, 0, 87, 88, 0,
+	0, 35, 600, 0, 0, 602, 0, 0, 0, 0,
+	0, 554, 526, 568, 0, 0, 0, 639, 0, 630,
+	0, 386, 48, 0, 370, 0, 0, 371, 0, 0,
+	0, 423, 183, 185, 0, 190, 0, 202, 0, 0,
+	181, 0, 178, 180, 167, 138, 139, 153, 156, 0,
+	0, 0, 0, 617, 0, 0, 511, 512, 0, 521,
+	523, 522, 524, 0, 0, 0, 543, 544, 0, 629,
+	0, 392, 402, 0, 424, 425, 426, 373, 186, 187,
+	0, 191, 189, 0, 384, 99, 0, 177, 179, 0,
+	251, 0, 89, 90, 83, 37, 0, 601, 589, 590,
+	592, 0, 0, 0, 514, 525, 0, 0, 0, 637,
+	-2, 381, 382, 188, 0, 182, 250, 0, 0, 86,
+	618, 619, 0, 0, 0, 0, 0, 0, 0, 555,
+	0, 558, 0, 252, 0, 237, 0, 621, 0, 0,
+	0, 625, 591, 0, 0, 0, 556, 0, 0, 620,
+	0, 624, 623, 593, 594, 0, 596, 0, 599, 0,
+	206, 0, 622, 595, 0, 598, 0, 207, 208, 0,
+	0, 597, 557, 209, 0, 0, 0, 0, 0, 210,
+	212, 213, 0, 0, 211, 253, 254, 214, 215, 216,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 111, 103, 3,
-	59, 61, 108, 106, 60, 107, 124, 109, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 283,
-	91, 90, 92, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 90, 3, 3, 3, 119, 111, 3,
+	67, 69, 116, 114, 68, 115, 132, 117, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 291,
+	99, 98, 100, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 284, 3, 285, 113, 3, 3, 3, 3, 3,
+	3, 292, 3, 293, 121, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 102, 3, 114,
+	3, 3, 3, 3, 110, 3, 122,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 89, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 104, 105, 110, 112, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 70, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 89, 91, 92, 93, 94, 95,
+	96, 97, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 112, 113, 118, 120, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
@@ -2914,9 +3169,11 @@ var yyTok2 = [...]int{
 	269, 270, 271, 272, 273, 274,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]uint16{
 	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
-	57605, 280, 57606, 281, 57607, 282, 0,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2997,9 +3254,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -3009,13 +3266,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -3046,30 +3303,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -3124,7 +3381,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -3135,8 +3392,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -3149,7 +3406,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -3158,18 +3415,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -3191,10 +3448,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -3230,7 +3487,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -3241,16 +3498,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -3258,35 +3515,35 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:340
+//line sql.y:348
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:345
+//line sql.y:353
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:354
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:358
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:372
+//line sql.y:380
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:378
+//line sql.y:386
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3296,85 +3553,121 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:386
+//line sql.y:394
 		{
 			yyVAL.selStmt = &With{CommonTableExpressions: yyDollar[2].commonTableExpressions, Select: yyDollar[4].selStmt}
 		}
 	case 26:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:398
+		{
+			yyVAL.selStmt = &With{Recursive: true, CommonTableExpressions: yyDollar[3].commonTableExpressions, Select: yyDollar[5].selStmt}
+		}
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:390
+//line sql.y:402
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 27:
+	case 28:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:406
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+		}
+	case 29:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:394
+//line sql.y:410
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:399
+//line sql.y:415
 		{
 			yyVAL.bytes = nil
 		}
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:419
 		{
 			yyVAL.bytes = []byte(",")
 		}
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:426
 		{
 			yyVAL.commonTableExpressions = []*CommonTableExpression{yyDollar[1].commonTableExpression}
 		}
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:414
+//line sql.y:430
 		{
 			yyVAL.commonTableExpressions = append(yyDollar[1].commonTableExpressions, yyDollar[3].commonTableExpression)
 		}
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:420
+//line sql.y:436
 		{
 			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Select: yyDollar[4].selStmt}
 		}
-	case 33:
+	case 35:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:440
+		{
+			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Select: yyDollar[7].selStmt}
+		}
+	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:427
+//line sql.y:447
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:434
+//line sql.y:454
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Trigger: yyDollar[11].triggers}
 		}
-	case 35:
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:461
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:440
+//line sql.y:465
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
 		}
-	case 36:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:446
+//line sql.y:471
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 37:
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:475
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:481
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:450
+//line sql.y:485
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 38:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:457
+//line sql.y:492
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3386,9 +3679,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 39:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:469
+//line sql.y:504
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3398,334 +3691,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 40:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:481
+//line sql.y:516
 		{
 			yyVAL.str = InsertStr
 		}
-	case 41:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:520
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 42:
+	case 48:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:491
+//line sql.y:526
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 43:
+	case 49:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:497
+//line sql.y:532
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:501
+//line sql.y:536
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 45:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:540
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 46:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:509
+//line sql.y:544
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 47:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:514
+//line sql.y:549
 		{
 		}
-	case 48:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:515
+//line sql.y:550
 		{
 		}
-	case 49:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:519
+//line sql.y:554
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:523
+//line sql.y:558
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:529
+//line sql.y:564
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 52:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:533
+//line sql.y:568
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 53:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:538
+//line sql.y:573
 		{
 			yyVAL.partitions = nil
 		}
-	case 54:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:542
+//line sql.y:577
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 55:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:583
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 56:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:552
+//line sql.y:587
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 63:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:556
+//line sql.y:591
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 58:
+	case 64:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:560
+//line sql.y:595
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 59:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:601
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 60:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:605
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 61:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:611
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 62:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:580
+//line sql.y:615
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 63:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:584
+//line sql.y:619
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 64:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:625
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 65:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:594
+//line sql.y:629
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 66:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:598
+//line sql.y:633
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 67:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:637
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 68:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:608
+//line sql.y:643
 		{
 			yyVAL.str = SessionStr
 		}
-	case 69:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:612
+//line sql.y:647
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 70:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:618
+//line sql.y:653
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 71:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:623
+//line sql.y:658
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 72:
+	case 78:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:629
+//line sql.y:664
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 73:
+	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:634
+//line sql.y:669
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 74:
+	case 80:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:638
+//line sql.y:673
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 75:
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:642
+//line sql.y:677
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 76:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:646
+//line sql.y:681
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 77:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:651
+//line sql.y:686
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 78:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:655
+//line sql.y:690
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 79:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:696
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 80:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:666
+//line sql.y:701
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 81:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:671
+//line sql.y:706
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 82:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:677
+//line sql.y:712
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 83:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:682
+//line sql.y:717
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 84:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:723
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 85:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:694
+//line sql.y:729
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 86:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:701
+//line sql.y:736
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 87:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:743
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 88:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:712
+//line sql.y:747
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 89:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:718
+//line sql.y:753
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 90:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:723
+//line sql.y:758
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 91:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:727
+//line sql.y:762
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 92:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:731
+//line sql.y:766
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 93:
+	case 99:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:737
+//line sql.y:772
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3735,814 +4028,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 94:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:748
+//line sql.y:783
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:759
+//line sql.y:794
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:764
+//line sql.y:799
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:770
+//line sql.y:805
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:774
+//line sql.y:809
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:778
+//line sql.y:813
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:782
+//line sql.y:817
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:786
+//line sql.y:821
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:790
+//line sql.y:825
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:829
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:833
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 108:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+//line sql.y:837
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 109:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:843
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:814
+//line sql.y:849
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:820
+//line sql.y:855
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:826
+//line sql.y:861
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 113:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:832
+//line sql.y:867
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 114:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:840
+//line sql.y:875
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 115:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:844
+//line sql.y:879
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:848
+//line sql.y:883
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:852
+//line sql.y:887
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:856
+//line sql.y:891
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 119:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:862
+//line sql.y:897
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 120:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+//line sql.y:901
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 121:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:870
+//line sql.y:905
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 122:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:909
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 123:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:878
+//line sql.y:913
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:882
+//line sql.y:917
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:921
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 126:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:890
+//line sql.y:925
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 127:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:929
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:933
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:937
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:941
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:945
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:914
+//line sql.y:949
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 133:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:919
+//line sql.y:954
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 134:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:960
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:964
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:933
+//line sql.y:968
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:937
+//line sql.y:972
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:941
+//line sql.y:976
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:945
+//line sql.y:980
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:949
+//line sql.y:984
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:953
+//line sql.y:988
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:994
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 143:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:964
+//line sql.y:999
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 144:
+	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:969
+//line sql.y:1004
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 145:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:973
+//line sql.y:1008
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 146:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:978
+//line sql.y:1013
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 153:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:982
+//line sql.y:1017
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 148:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:990
+//line sql.y:1025
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 149:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:994
+//line sql.y:1029
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 150:
+	case 156:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1000
+//line sql.y:1035
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 151:
+	case 157:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1008
+//line sql.y:1043
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1012
+//line sql.y:1047
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1017
+//line sql.y:1052
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1021
+//line sql.y:1056
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 155:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1062
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 156:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1066
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 157:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1035
+//line sql.y:1070
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 158:
+	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1040
+//line sql.y:1075
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1044
+//line sql.y:1079
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 160:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1084
 		{
 			yyVAL.optVal = nil
 		}
-	case 161:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1053
+//line sql.y:1088
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 162:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1058
+//line sql.y:1093
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 163:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1062
+//line sql.y:1097
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 164:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1067
+//line sql.y:1102
 		{
 			yyVAL.str = ""
 		}
-	case 165:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1071
+//line sql.y:1106
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 166:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1075
+//line sql.y:1110
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 167:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1080
+//line sql.y:1115
 		{
 			yyVAL.str = ""
 		}
-	case 168:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1084
+//line sql.y:1119
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 169:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1088
+//line sql.y:1123
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 170:
+	case 176:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1093
+//line sql.y:1128
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 171:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1132
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 172:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1101
+//line sql.y:1136
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 173:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1105
+//line sql.y:1140
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 174:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1144
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 175:
+	case 181:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1114
+//line sql.y:1149
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 176:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1118
+//line sql.y:1153
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 177:
+	case 183:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1124
+//line sql.y:1159
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 178:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1128
+//line sql.y:1163
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 179:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1169
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 180:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1138
+//line sql.y:1173
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 181:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1144
+//line sql.y:1179
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1148
+//line sql.y:1183
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 183:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1153
+//line sql.y:1188
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 184:
+	case 190:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1159
+//line sql.y:1194
 		{
 			yyVAL.str = ""
 		}
-	case 185:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1198
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 186:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1169
+//line sql.y:1204
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 187:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1173
+//line sql.y:1208
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 188:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1177
+//line sql.y:1212
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 189:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1181
+//line sql.y:1216
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 190:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1185
+//line sql.y:1220
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 191:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1226
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 192:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1230
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 193:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1200
+//line sql.y:1235
 		{
 			yyVAL.str = ""
 		}
-	case 194:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1204
+//line sql.y:1239
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 195:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1245
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 196:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1214
+//line sql.y:1249
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 197:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1255
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 198:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1226
+//line sql.y:1261
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 199:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1265
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 200:
+	case 206:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1237
+//line sql.y:1272
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 201:
+	case 207:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1241
+//line sql.y:1276
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 202:
+	case 208:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1245
+//line sql.y:1280
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 203:
+	case 209:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1249
+//line sql.y:1284
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 204:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1255
+//line sql.y:1290
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 205:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1261
+//line sql.y:1296
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 206:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1302
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 207:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1306
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 208:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1275
+//line sql.y:1310
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 209:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1314
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 210:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1318
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 211:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1288
+//line sql.y:1323
 		{
 			yyVAL.str = ""
 		}
-	case 212:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1292
+//line sql.y:1327
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 213:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1296
+//line sql.y:1331
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 214:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1304
+//line sql.y:1339
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 215:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1308
+//line sql.y:1343
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 216:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1312
+//line sql.y:1347
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 217:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1353
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 218:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1357
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 219:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1326
+//line sql.y:1361
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 220:
+	case 226:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1332
+//line sql.y:1367
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 221:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1336
+//line sql.y:1371
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 222:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1340
+//line sql.y:1375
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 223:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1344
+//line sql.y:1379
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 224:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1349
+//line sql.y:1384
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 225:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1354
+//line sql.y:1389
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 226:
+	case 232:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1358
+//line sql.y:1393
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 227:
+	case 233:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1362
+//line sql.y:1397
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[5].colIdent,
@@ -4550,29 +4843,29 @@ yydefault:
 				Params: yyDollar[7].vindexParams,
 			}}
 		}
-	case 228:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1405
 		{
 			yyVAL.statement = &DDL{Action: DropVindexStr, VindexSpec: &VindexSpec{
 				Name: yyDollar[5].colIdent,
 			}}
 		}
-	case 229:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1376
+//line sql.y:1411
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 230:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1415
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 237:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1384
+//line sql.y:1419
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4585,9 +4878,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 232:
+	case 238:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1397
+//line sql.y:1432
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4597,59 +4890,59 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 250:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1422
+//line sql.y:1457
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1428
+//line sql.y:1463
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1467
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 253:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1438
+//line sql.y:1473
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 254:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1442
+//line sql.y:1477
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1448
+//line sql.y:1483
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1454
+//line sql.y:1489
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 257:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1458
+//line sql.y:1493
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1466
+//line sql.y:1501
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4657,16 +4950,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 253:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1474
+//line sql.y:1509
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 260:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1479
+//line sql.y:1514
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4674,148 +4967,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 261:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1487
+//line sql.y:1522
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1491
+//line sql.y:1526
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1497
+//line sql.y:1532
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1501
+//line sql.y:1536
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1506
+//line sql.y:1541
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1512
+//line sql.y:1547
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1517
+//line sql.y:1552
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 262:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1521
+//line sql.y:1556
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1526
+//line sql.y:1561
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1530
+//line sql.y:1565
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1534
+//line sql.y:1569
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 266:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1538
+//line sql.y:1573
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1542
+//line sql.y:1577
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1546
+//line sql.y:1581
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1585
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1554
+//line sql.y:1589
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1558
+//line sql.y:1593
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1562
+//line sql.y:1597
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1566
+//line sql.y:1601
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1570
+//line sql.y:1605
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1574
+//line sql.y:1609
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1613
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 283:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1582
+//line sql.y:1617
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 284:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1587
+//line sql.y:1622
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -4825,734 +5118,782 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1597
+//line sql.y:1632
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1601
+//line sql.y:1636
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1640
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1646
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 283:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1650
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 284:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1654
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1623
+//line sql.y:1658
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1627
+//line sql.y:1662
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1631
+//line sql.y:1666
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 294:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1635
+//line sql.y:1670
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 289:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1639
+//line sql.y:1674
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1649
+//line sql.y:1684
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 291:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1690
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1694
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1665
+//line sql.y:1700
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1669
+//line sql.y:1704
 		{
 			yyVAL.str = "full "
 		}
-	case 295:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1675
+//line sql.y:1710
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 296:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1679
+//line sql.y:1714
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 297:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1685
+//line sql.y:1720
 		{
 			yyVAL.str = ""
 		}
-	case 298:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1689
+//line sql.y:1724
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 299:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1693
+//line sql.y:1728
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 300:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1699
+//line sql.y:1734
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+//line sql.y:1738
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1707
+//line sql.y:1742
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 303:
+	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1713
+//line sql.y:1748
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1717
+//line sql.y:1752
 		{
 			yyVAL.str = SessionStr
 		}
-	case 305:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1721
+//line sql.y:1756
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 306:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1727
+//line sql.y:1762
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 307:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1766
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 308:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1772
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:1776
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 310:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1782
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 311:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1753
+//line sql.y:1788
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 312:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1759
+//line sql.y:1794
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1763
+//line sql.y:1798
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1802
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 315:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1806
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1775
+//line sql.y:1810
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1779
+//line sql.y:1814
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:1818
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 319:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1789
+//line sql.y:1824
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 320:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1793
+//line sql.y:1828
 		{
 			setAllowComments(yylex, true)
 		}
-	case 321:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1797
+//line sql.y:1832
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 322:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1803
+//line sql.y:1838
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 323:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1807
+//line sql.y:1842
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 324:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1848
 		{
 			yyVAL.str = UnionStr
 		}
-	case 325:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1817
+//line sql.y:1852
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 326:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:1856
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 327:
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1860
+		{
+			yyVAL.str = ExceptStr
+		}
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1864
+		{
+			yyVAL.str = ExceptAllStr
+		}
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1868
+		{
+			yyVAL.str = ExceptDistinctStr
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1874
+		{
+			yyVAL.str = IntersectStr
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1878
+		{
+			yyVAL.str = IntersectAllStr
+		}
+	case 338:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1882
+		{
+			yyVAL.str = IntersectDistinctStr
+		}
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1826
+//line sql.y:1887
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1830
+//line sql.y:1891
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1834
+//line sql.y:1895
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1839
+//line sql.y:1900
 		{
 			yyVAL.str = ""
 		}
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1843
+//line sql.y:1904
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1848
+//line sql.y:1909
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1852
+//line sql.y:1913
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1857
+//line sql.y:1918
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1861
+//line sql.y:1922
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1867
+//line sql.y:1928
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1871
+//line sql.y:1932
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+//line sql.y:1938
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 339:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1881
+//line sql.y:1942
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1946
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 341:
+	case 353:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1889
+//line sql.y:1950
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 342:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1893
+//line sql.y:1954
 		{
 			yyVAL.selectExpr = &ObjectExplode{Object: yyDollar[1].expr}
 		}
-	case 343:
+	case 355:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1898
+//line sql.y:1959
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 344:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1902
+//line sql.y:1963
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 345:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1906
+//line sql.y:1967
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 347:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:1974
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 348:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1918
+//line sql.y:1979
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 349:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1922
+//line sql.y:1983
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 350:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:1989
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 351:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1932
+//line sql.y:1993
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 354:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1942
+//line sql.y:2003
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 355:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2007
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 356:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1950
+//line sql.y:2011
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 357:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1956
+//line sql.y:2017
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 358:
+	case 370:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1960
+//line sql.y:2021
 		{
 			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
 		}
-	case 359:
+	case 371:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2025
+		{
+			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent("session"), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
+		}
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2031
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 360:
+	case 373:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1970
+//line sql.y:2035
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 361:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1975
+//line sql.y:2040
 		{
 			yyVAL.tableValuedFunctionArguments = nil
 		}
-	case 362:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1979
+//line sql.y:2044
 		{
 			yyVAL.tableValuedFunctionArguments = yyDollar[1].tableValuedFunctionArguments
 		}
-	case 363:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1985
+//line sql.y:2050
 		{
 			yyVAL.tableValuedFunctionArguments = TableValuedFunctionArguments{yyDollar[1].tableValuedFunctionArgument}
 		}
-	case 364:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1989
+//line sql.y:2054
 		{
 			yyVAL.tableValuedFunctionArguments = append(yyVAL.tableValuedFunctionArguments, yyDollar[3].tableValuedFunctionArgument)
 		}
-	case 365:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1995
+//line sql.y:2060
 		{
 			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Name: yyDollar[1].colIdent, Value: yyDollar[3].tableValuedFunctionArgumentValue}
 		}
-	case 366:
+	case 379:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2064
+		{
+			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Name: NewColIdent("key"), Value: yyDollar[3].tableValuedFunctionArgumentValue}
+		}
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2001
+//line sql.y:2070
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &ExprTableValuedFunctionArgumentValue{Expr: yyDollar[1].expr}
 		}
-	case 367:
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2005
+//line sql.y:2074
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &TableDescriptorTableValuedFunctionArgumentValue{Table: yyDollar[3].tableExpr}
 		}
-	case 368:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2009
+//line sql.y:2078
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &FieldDescriptorTableValuedFunctionArgumentValue{Field: yyDollar[3].colName}
 		}
-	case 369:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2084
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 370:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2019
+//line sql.y:2088
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 371:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2094
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 372:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2029
+//line sql.y:2098
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 373:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2042
+//line sql.y:2111
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 374:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2046
+//line sql.y:2115
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 375:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2050
+//line sql.y:2119
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 376:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2123
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 377:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2060
+//line sql.y:2129
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 378:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2062
+//line sql.y:2131
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 379:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2066
+//line sql.y:2135
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 380:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:2137
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 381:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2072
+//line sql.y:2141
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 382:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2143
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 383:
+	case 397:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2077
+//line sql.y:2146
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 384:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2079
+//line sql.y:2148
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 385:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2082
+//line sql.y:2151
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 386:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2155
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 387:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2090
+//line sql.y:2159
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 388:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2095
+//line sql.y:2164
 		{
 			yyVAL.str = UndefinedJoinStrategy
 		}
-	case 389:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2097
+//line sql.y:2166
 		{
 			yyVAL.str = LookupJoinStrategy
 		}
-	case 390:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2168
 		{
 			yyVAL.str = StreamJoinStrategy
 		}
-	case 392:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2104
+//line sql.y:2173
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 393:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:2179
 		{
 			yyVAL.str = JoinStr
 		}
-	case 394:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2114
+//line sql.y:2183
 		{
 			yyVAL.str = JoinStr
 		}
-	case 395:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2118
+//line sql.y:2187
 		{
 			yyVAL.str = JoinStr
 		}
-	case 396:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2124
+//line sql.y:2193
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 397:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2130
+//line sql.y:2199
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 398:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2134
+//line sql.y:2203
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 399:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2138
+//line sql.y:2207
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 400:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:2211
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 401:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2146
+//line sql.y:2215
 		{
 			yyVAL.str = OuterJoinStr
 		}
-	case 402:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2152
+//line sql.y:2221
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 403:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2225
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5560,477 +5901,477 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 404:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2166
+//line sql.y:2235
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 405:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2170
+//line sql.y:2239
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 406:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2176
+//line sql.y:2245
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 407:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2180
+//line sql.y:2249
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 408:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2255
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 409:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2191
+//line sql.y:2260
 		{
 			yyVAL.indexHints = nil
 		}
-	case 410:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2195
+//line sql.y:2264
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 411:
+	case 425:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2199
+//line sql.y:2268
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 412:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2203
+//line sql.y:2272
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 413:
+	case 427:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2208
+//line sql.y:2277
 		{
 			yyVAL.expr = nil
 		}
-	case 414:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2212
+//line sql.y:2281
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 415:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2218
+//line sql.y:2287
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 416:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2222
+//line sql.y:2291
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 417:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2226
+//line sql.y:2295
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 418:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2230
+//line sql.y:2299
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 419:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2234
+//line sql.y:2303
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 420:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2307
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 421:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2242
+//line sql.y:2311
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 422:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2248
+//line sql.y:2317
 		{
 			yyVAL.str = ""
 		}
-	case 423:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2252
+//line sql.y:2321
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 424:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2258
+//line sql.y:2327
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 425:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2262
+//line sql.y:2331
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 426:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2337
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 427:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2272
+//line sql.y:2341
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 428:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2276
+//line sql.y:2345
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 429:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2280
+//line sql.y:2349
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 430:
+	case 444:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2284
+//line sql.y:2353
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 431:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2288
+//line sql.y:2357
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2292
+//line sql.y:2361
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 433:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2296
+//line sql.y:2365
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 434:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2300
+//line sql.y:2369
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 435:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2304
+//line sql.y:2373
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 436:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2308
+//line sql.y:2377
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 437:
+	case 451:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2312
+//line sql.y:2381
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 438:
+	case 452:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2316
+//line sql.y:2385
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 439:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2320
+//line sql.y:2389
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 440:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2326
+//line sql.y:2395
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 441:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2330
+//line sql.y:2399
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 442:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2403
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 443:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2338
+//line sql.y:2407
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 444:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2342
+//line sql.y:2411
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 445:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2346
+//line sql.y:2415
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 446:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2352
+//line sql.y:2421
 		{
 			yyVAL.str = EqualStr
 		}
-	case 447:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2356
+//line sql.y:2425
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 448:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2360
+//line sql.y:2429
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 449:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2364
+//line sql.y:2433
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 450:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2368
+//line sql.y:2437
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 451:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2372
+//line sql.y:2441
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 452:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2376
+//line sql.y:2445
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 453:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2381
+//line sql.y:2450
 		{
 			yyVAL.expr = nil
 		}
-	case 454:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2385
+//line sql.y:2454
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 455:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2391
+//line sql.y:2460
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 456:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2395
+//line sql.y:2464
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 457:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2399
+//line sql.y:2468
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 458:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2405
+//line sql.y:2474
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 459:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2411
+//line sql.y:2480
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 460:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2415
+//line sql.y:2484
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 461:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2421
+//line sql.y:2490
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 462:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2425
+//line sql.y:2494
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 463:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2429
+//line sql.y:2498
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 464:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2433
+//line sql.y:2502
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 465:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2437
+//line sql.y:2506
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 466:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2441
+//line sql.y:2510
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 467:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2445
+//line sql.y:2514
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2449
+//line sql.y:2518
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 469:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2453
+//line sql.y:2522
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 470:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2526
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 471:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2530
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 472:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2465
+//line sql.y:2534
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 473:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2469
+//line sql.y:2538
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 474:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2473
+//line sql.y:2542
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 475:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2477
+//line sql.y:2546
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 476:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2481
+//line sql.y:2550
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 477:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2485
+//line sql.y:2554
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 478:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2489
+//line sql.y:2558
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 479:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2493
+//line sql.y:2562
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 480:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2566
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 481:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2501
+//line sql.y:2570
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 482:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2574
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6038,9 +6379,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 483:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2513
+//line sql.y:2582
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6054,21 +6395,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 484:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2527
+//line sql.y:2596
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 485:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2531
+//line sql.y:2600
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 486:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2535
+//line sql.y:2604
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6076,447 +6417,477 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 491:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2547
+//line sql.y:2616
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ArrayElement, Right: yyDollar[3].expr}
 		}
-	case 492:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2551
+//line sql.y:2620
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
 		}
-	case 493:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2555
+//line sql.y:2624
 		{
 			yyVAL.expr = &ObjectFieldAccess{Object: yyDollar[1].expr, Field: yyDollar[3].colIdent}
 		}
-	case 494:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2565
+//line sql.y:2634
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 495:
+	case 509:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2569
+//line sql.y:2638
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 496:
+	case 510:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2573
+//line sql.y:2642
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 497:
+	case 511:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2646
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[7].windowSpec}
+		}
+	case 512:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2652
+		{
+			yyVAL.windowSpec = &WindowSpec{PartitionBy: yyDollar[1].exprs, OrderBy: yyDollar[2].orderBy}
+		}
+	case 513:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2657
+		{
+			yyVAL.exprs = nil
+		}
+	case 514:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2661
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 515:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2583
+//line sql.y:2671
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 498:
+	case 516:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2587
+//line sql.y:2675
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 499:
+	case 517:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("grouping"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 518:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2591
+//line sql.y:2683
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 500:
+	case 519:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2595
+//line sql.y:2687
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 501:
+	case 520:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2599
+//line sql.y:2691
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 502:
+	case 521:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2603
+//line sql.y:2695
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 503:
+	case 522:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2607
+//line sql.y:2699
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 504:
+	case 523:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2611
+//line sql.y:2703
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 505:
+	case 524:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2615
+//line sql.y:2707
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 506:
+	case 525:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2619
+//line sql.y:2711
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 507:
+	case 526:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2623
+//line sql.y:2715
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 508:
+	case 527:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2627
+//line sql.y:2719
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 509:
+	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2631
+//line sql.y:2723
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 510:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2733
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 511:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2645
+//line sql.y:2737
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 512:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2649
+//line sql.y:2741
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 513:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2654
+//line sql.y:2746
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 514:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:2751
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 515:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:2756
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 516:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2670
+//line sql.y:2762
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 517:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2675
+//line sql.y:2767
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 518:
+	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2772
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 519:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2684
+//line sql.y:2776
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 520:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2688
+//line sql.y:2780
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 521:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2693
+//line sql.y:2785
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 522:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2698
+//line sql.y:2790
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 523:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2703
+//line sql.y:2795
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 524:
+	case 543:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2707
+//line sql.y:2799
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 525:
+	case 544:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2711
+//line sql.y:2803
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 528:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2721
+//line sql.y:2813
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 529:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2731
+//line sql.y:2823
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 530:
+	case 549:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2735
+//line sql.y:2827
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 531:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2739
+//line sql.y:2831
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2743
+//line sql.y:2835
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2747
+//line sql.y:2839
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 534:
+	case 553:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2751
+//line sql.y:2843
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 535:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2757
+//line sql.y:2849
 		{
 			yyVAL.str = ""
 		}
-	case 536:
+	case 555:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2761
+//line sql.y:2853
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 537:
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2765
+//line sql.y:2857
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 538:
+	case 557:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2769
+//line sql.y:2861
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 539:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2773
+//line sql.y:2865
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 540:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2779
+//line sql.y:2871
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 541:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:2875
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 542:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2789
+//line sql.y:2881
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 543:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:2885
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 544:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2797
+//line sql.y:2889
 		{
 			yyVAL.convertType = &ConvertTypeList{}
 		}
-	case 545:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2801
+//line sql.y:2893
 		{
 			yyVAL.convertType = &ConvertTypeObject{}
 		}
-	case 546:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2806
+//line sql.y:2898
 		{
 			yyVAL.expr = nil
 		}
-	case 547:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2810
+//line sql.y:2902
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 548:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2815
+//line sql.y:2907
 		{
 			yyVAL.str = string("")
 		}
-	case 549:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2819
+//line sql.y:2911
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 550:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2825
+//line sql.y:2917
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 551:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2829
+//line sql.y:2921
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 552:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2835
+//line sql.y:2927
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 553:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2840
+//line sql.y:2932
 		{
 			yyVAL.expr = nil
 		}
-	case 554:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2844
+//line sql.y:2936
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 555:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2850
+//line sql.y:2942
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 556:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2854
+//line sql.y:2946
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 557:
+	case 576:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2858
+//line sql.y:2950
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 558:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2864
+//line sql.y:2956
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 559:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:2960
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 560:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2872
+//line sql.y:2964
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 561:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2876
+//line sql.y:2968
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 562:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2880
+//line sql.y:2972
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 563:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2884
+//line sql.y:2976
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 564:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2888
+//line sql.y:2980
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 565:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2892
+//line sql.y:2984
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 566:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2898
+//line sql.y:2990
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6525,287 +6896,360 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 567:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2907
+//line sql.y:2999
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 568:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2911
+//line sql.y:3003
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 569:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2916
+//line sql.y:3008
 		{
 			yyVAL.exprs = nil
 		}
-	case 570:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2920
+//line sql.y:3012
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 571:
+	case 590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3018
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 591:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3022
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 592:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3028
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 593:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3032
+		{
+			yyVAL.expr = &RollupExpr{Exprs: yyDollar[3].exprs}
+		}
+	case 594:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3036
+		{
+			yyVAL.expr = &CubeExpr{Exprs: yyDollar[3].exprs}
+		}
+	case 595:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3040
+		{
+			yyVAL.expr = &GroupingSetsExpr{Sets: yyDollar[4].exprsList}
+		}
+	case 596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3046
+		{
+			yyVAL.exprsList = []Exprs{yyDollar[1].exprs}
+		}
+	case 597:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3050
+		{
+			yyVAL.exprsList = append(yyDollar[1].exprsList, yyDollar[3].exprs)
+		}
+	case 598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3056
+		{
+			yyVAL.exprs = Exprs{}
+		}
+	case 599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3060
+		{
+			switch expr := yyDollar[1].expr.(type) {
+			case ValTuple:
+				yyVAL.exprs = Exprs(expr)
+			case *ParenExpr:
+				yyVAL.exprs = Exprs{expr.Expr}
+			default:
+				yyVAL.exprs = Exprs{expr}
+			}
+		}
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2925
+//line sql.y:3072
 		{
 			yyVAL.expr = nil
 		}
-	case 572:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2929
+//line sql.y:3076
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 573:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2934
+//line sql.y:3081
 		{
 			yyVAL.orderBy = nil
 		}
-	case 574:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2938
+//line sql.y:3085
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 575:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2944
+//line sql.y:3091
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 576:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2948
+//line sql.y:3095
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 577:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2954
+//line sql.y:3101
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 578:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2959
+//line sql.y:3106
 		{
 			yyVAL.str = AscScr
 		}
-	case 579:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2963
+//line sql.y:3110
 		{
 			yyVAL.str = AscScr
 		}
-	case 580:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2967
+//line sql.y:3114
 		{
 			yyVAL.str = DescScr
 		}
-	case 581:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2972
+//line sql.y:3119
 		{
 			yyVAL.limit = nil
 		}
-	case 582:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2976
+//line sql.y:3123
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 583:
+	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2980
+//line sql.y:3127
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 584:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2984
+//line sql.y:3131
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 585:
+	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2989
+//line sql.y:3136
 		{
 			yyVAL.str = ""
 		}
-	case 586:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3140
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 587:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2997
+//line sql.y:3144
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 588:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3002
+//line sql.y:3149
 		{
 			yyVAL.triggers = nil
 		}
-	case 589:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3006
+//line sql.y:3153
 		{
 			yyVAL.triggers = yyDollar[2].triggers
 		}
-	case 590:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3012
+//line sql.y:3159
 		{
 			yyVAL.triggers = []Trigger{yyDollar[1].trigger}
 		}
-	case 591:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3163
 		{
 			yyVAL.triggers = append(yyDollar[1].triggers, yyDollar[3].trigger)
 		}
-	case 592:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3022
+//line sql.y:3169
 		{
 			yyVAL.trigger = &WatermarkTrigger{}
 		}
-	case 593:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3026
+//line sql.y:3173
 		{
 			yyVAL.trigger = &EndOfStreamTrigger{}
 		}
-	case 594:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3177
 		{
 			yyVAL.trigger = &DelayTrigger{Delay: yyDollar[3].expr}
 		}
-	case 595:
+	case 624:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3181
+		{
+			yyVAL.trigger = &DelayTrigger{Delay: yyDollar[3].expr}
+		}
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3034
+//line sql.y:3185
 		{
 			yyVAL.trigger = &CountingTrigger{Count: yyDollar[2].expr}
 		}
-	case 596:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3047
+//line sql.y:3198
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 597:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3051
+//line sql.y:3202
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 598:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3055
+//line sql.y:3206
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 599:
+	case 629:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3060
+//line sql.y:3211
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 600:
+	case 630:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3064
+//line sql.y:3215
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 601:
+	case 631:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3068
+//line sql.y:3219
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 602:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3075
+//line sql.y:3226
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 603:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3079
+//line sql.y:3230
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 604:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3083
+//line sql.y:3234
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 605:
+	case 635:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3087
+//line sql.y:3238
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 606:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3092
+//line sql.y:3243
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 607:
+	case 637:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3096
+//line sql.y:3247
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 608:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3102
+//line sql.y:3253
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 609:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3106
+//line sql.y:3257
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 610:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3112
+//line sql.y:3263
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 611:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3116
+//line sql.y:3267
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 612:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3122
+//line sql.y:3273
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 613:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3128
+//line sql.y:3279
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6813,312 +7257,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 614:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3138
+//line sql.y:3289
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 615:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3142
+//line sql.y:3293
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 616:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3148
+//line sql.y:3299
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 617:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3305
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 618:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3158
+//line sql.y:3309
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 619:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3164
+//line sql.y:3315
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 620:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3168
+//line sql.y:3319
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 621:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3172
+//line sql.y:3323
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 622:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3327
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 624:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3183
+//line sql.y:3334
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 626:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3190
+//line sql.y:3341
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 627:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3194
+//line sql.y:3345
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 628:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3198
+//line sql.y:3349
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 631:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3207
+//line sql.y:3358
 		{
 			yyVAL.byt = 0
 		}
-	case 632:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3209
+//line sql.y:3360
 		{
 			yyVAL.byt = 1
 		}
-	case 633:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3212
+//line sql.y:3363
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3214
+//line sql.y:3365
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3217
+//line sql.y:3368
 		{
 			yyVAL.str = ""
 		}
-	case 636:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3219
+//line sql.y:3370
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 637:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3374
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3376
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3378
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3229
+//line sql.y:3380
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3382
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3233
+//line sql.y:3384
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3235
+//line sql.y:3386
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3237
+//line sql.y:3388
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3239
+//line sql.y:3390
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3241
+//line sql.y:3392
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 677:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3244
+//line sql.y:3395
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3246
+//line sql.y:3397
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3248
+//line sql.y:3399
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3252
+//line sql.y:3403
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3254
+//line sql.y:3405
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3257
+//line sql.y:3408
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3259
+//line sql.y:3410
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3261
+//line sql.y:3412
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 685:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3264
+//line sql.y:3415
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 656:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3266
+//line sql.y:3417
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 657:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3270
+//line sql.y:3421
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 658:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3274
+//line sql.y:3425
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 660:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3281
+//line sql.y:3432
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 661:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3287
+//line sql.y:3438
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 662:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3291
+//line sql.y:3442
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 664:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3298
+//line sql.y:3449
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 880:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3539
+//line sql.y:3698
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 881:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3548
+//line sql.y:3707
 		{
 			decNesting(yylex)
 		}
-	case 882:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3553
+//line sql.y:3712
 		{
 			skipToEnd(yylex)
 		}
-	case 883:
+	case 921:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3558
+//line sql.y:3717
 		{
 			skipToEnd(yylex)
 		}
-	case 884:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3562
+//line sql.y:3721
 		{
 			skipToEnd(yylex)
 		}
-	case 885:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3566
+//line sql.y:3725
 		{
 			skipToEnd(yylex)
 		}
@@ -86,6 +86,7 @@ func skipToEnd(yylex interface{}) {
   indexHints    *IndexHints
   expr          Expr
   exprs         Exprs
+  exprsList     []Exprs
   boolVal       BoolVal
   sqlVal        *SQLVal
   colTuple      ColTuple
@@ -95,6 +96,7 @@ func skipToEnd(yylex interface{}) {
   whens         []*When
   when          *When
   orderBy       OrderBy
+  windowSpec    *WindowSpec
   order         *Order
   limit         *Limit
   triggers 			[]Trigger
@@ -132,8 +134,10 @@ func skipToEnd(yylex interface{}) {
 }
 
 %token LEX_ERROR
-%left <bytes> UNION
-%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR WATERMARK DELAY COUNTING AFTER
+%left <bytes> UNION EXCEPT
+%left <bytes> INTERSECT
+%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR WATERMARK DELAY COUNTING AFTER OVER RECURSIVE
+%token <bytes> ROLLUP CUBE GROUPING SETS
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
@@ -217,7 +221,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> UNUSED
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement base_select union_lhs intersect_lhs union_rhs
 %type <bytes> comma_opt
 %type <commonTableExpression> cte
 %type <commonTableExpressions> cte_list
@@ -227,7 +231,7 @@ func skipToEnd(yylex interface{}) {
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement
 %type <bytes2> comment_opt comment_list
-%type <str> union_op insert_or_replace
+%type <str> union_op intersect_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
 %type <expr> like_escape_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
@@ -262,9 +266,13 @@ func skipToEnd(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt group_by_list grouping_set
+%type <expr> group_by_element
+%type <exprsList> grouping_set_list
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
+%type <windowSpec> window_spec
+%type <exprs> partition_by_opt
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
@@ -386,7 +394,15 @@ select_statement:
 	{
 		$$ = &With{CommonTableExpressions: $2, Select: $4}
 	}
-| union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
+| WITH RECURSIVE cte_list comma_opt select_statement
+	{
+		$$ = &With{Recursive: true, CommonTableExpressions: $3, Select: $5}
+	}
+| union_lhs union_op intersect_lhs order_by_opt limit_opt lock_opt
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
+  }
+| intersect_lhs intersect_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
   }
@@ -420,6 +436,10 @@ cte:
 	{
 		$$ = &CommonTableExpression{Name: $1, Select: $4}
 	}
+|	table_alias openb ins_column_list closeb AS openb select_statement closeb
+	{
+		$$ = &CommonTableExpression{Name: $1, Columns: $3, Select: $7}
+	}
 
 
 stream_statement:
@@ -435,10 +455,25 @@ base_select:
     $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Trigger: $11}
   }
 
+// INTERSECT binds tighter than UNION and EXCEPT, so union_lhs is built out of intersect_lhs operands.
 union_lhs:
-	openb select_statement closeb
+  intersect_lhs
   {
-    $$ = &ParenSelect{Select: $2}
+    $$ = $1
+  }
+| union_lhs union_op intersect_lhs
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
+  }
+
+intersect_lhs:
+  union_rhs
+  {
+    $$ = $1
+  }
+| intersect_lhs intersect_op union_rhs
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
   }
 
 union_rhs:
@@ -1821,6 +1856,32 @@ union_op:
   {
     $$ = UnionDistinctStr
   }
+| EXCEPT
+  {
+    $$ = ExceptStr
+  }
+| EXCEPT ALL
+  {
+    $$ = ExceptAllStr
+  }
+| EXCEPT DISTINCT
+  {
+    $$ = ExceptDistinctStr
+  }
+
+intersect_op:
+  INTERSECT
+  {
+    $$ = IntersectStr
+  }
+| INTERSECT ALL
+  {
+    $$ = IntersectAllStr
+  }
+| INTERSECT DISTINCT
+  {
+    $$ = IntersectDistinctStr
+  }
 
 cache_opt:
 {
@@ -1960,6 +2021,10 @@ table_factor:
   {
     $$ = &TableValuedFunction{Name: NewColIdent(string($1)), Args: $3, As: $6}
   }
+| SESSION openb table_valued_function_arguments_opt closeb as_opt table_id
+  {
+    $$ = &TableValuedFunction{Name: NewColIdent("session"), Args: $3, As: $6}
+  }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
@@ -1995,6 +2060,10 @@ table_valued_function_argument:
   {
     $$ = &TableValuedFunctionArgument{Name: $1, Value: $3}
   }
+| KEY RIGHTARROW table_valued_function_argument_value
+  {
+    $$ = &TableValuedFunctionArgument{Name: NewColIdent("key"), Value: $3}
+  }
 
 table_valued_function_argument_value:
   expression
@@ -2573,6 +2642,25 @@ function_call_generic:
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| sql_id openb select_expression_list_opt closeb OVER openb window_spec closeb
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $7}
+  }
+
+window_spec:
+  partition_by_opt order_by_opt
+  {
+    $$ = &WindowSpec{PartitionBy: $1, OrderBy: $2}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -2587,6 +2675,10 @@ function_call_keyword:
   {
     $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
   }
+| GROUPING openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("grouping"), Exprs: $3}
+  }
 | CONVERT openb expression ',' convert_type closeb
   {
     $$ = &ConvertExpr{Expr: $3, Type: $5}
@@ -2916,11 +3008,66 @@ group_by_opt:
   {
     $$ = nil
   }
-| GROUP BY expression_list
+| GROUP BY group_by_list
   {
     $$ = $3
   }
 
+group_by_list:
+  group_by_element
+  {
+    $$ = Exprs{$1}
+  }
+| group_by_list ',' group_by_element
+  {
+    $$ = append($1, $3)
+  }
+
+group_by_element:
+  expression
+  {
+    $$ = $1
+  }
+| ROLLUP openb expression_list closeb
+  {
+    $$ = &RollupExpr{Exprs: $3}
+  }
+| CUBE openb expression_list closeb
+  {
+    $$ = &CubeExpr{Exprs: $3}
+  }
+| GROUPING SETS openb grouping_set_list closeb
+  {
+    $$ = &GroupingSetsExpr{Sets: $4}
+  }
+
+grouping_set_list:
+  grouping_set
+  {
+    $$ = []Exprs{$1}
+  }
+| grouping_set_list ',' grouping_set
+  {
+    $$ = append($1, $3)
+  }
+
+grouping_set:
+  openb closeb
+  {
+    $$ = Exprs{}
+  }
+| expression
+  {
+    switch expr := $1.(type) {
+    case ValTuple:
+      $$ = Exprs(expr)
+    case *ParenExpr:
+      $$ = Exprs{expr.Expr}
+    default:
+      $$ = Exprs{expr}
+    }
+  }
+
 having_opt:
   {
     $$ = nil
@@ -3030,6 +3177,10 @@ trigger:
   {
     $$ = &DelayTrigger{Delay: $3}
   }
+| ON DELAY expression
+  {
+    $$ = &DelayTrigger{Delay: $3}
+  }
 | COUNTING expression
   {
     $$ = &CountingTrigger{Count: $2}
@@ -3322,6 +3473,7 @@ reserved_keyword:
 | CONVERT
 | CREATE
 | CROSS
+| CUBE
 | CURRENT_DATE
 | CURRENT_TIME
 | CURRENT_TIMESTAMP
@@ -3338,6 +3490,7 @@ reserved_keyword:
 | DROP
 | ELSE
 | ESCAPE
+| EXCEPT
 | EXISTS
 | EXPLAIN
 | FALSE
@@ -3345,6 +3498,7 @@ reserved_keyword:
 | FORCE
 | FROM
 | GROUP
+| GROUPING
 | HAVING
 | IF
 | IGNORE
@@ -3352,6 +3506,7 @@ reserved_keyword:
 | INDEX
 | INNER
 | INSERT
+| INTERSECT
 | INTERVAL
 | INTO
 | IS
@@ -3375,10 +3530,13 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| OVER
+| RECURSIVE
 | REGEXP
 | RENAME
 | REPLACE
 | RIGHT
+| ROLLUP
 | SCHEMA
 | SELECT
 | SEPARATOR
@@ -3496,6 +3654,7 @@ non_reserved_keyword:
 | ROLLBACK
 | SCHEMAS
 | SESSION
+| SETS
 | SERIALIZABLE
 | SHARE
 | SIGNED
@@ -141,6 +141,7 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"cube":                CUBE,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -180,6 +181,7 @@ var keywords = map[string]int{
 	"enum":                ENUM,
 	"escape":              ESCAPE,
 	"escaped":             UNUSED,
+	"except":              EXCEPT,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -205,6 +207,7 @@ var keywords = map[string]int{
 	"grant":               UNUSED,
 	"group":               GROUP,
 	"group_concat":        GROUP_CONCAT,
+	"grouping":            GROUPING,
 	"having":              HAVING,
 	"high_priority":       UNUSED,
 	"hour_microsecond":    UNUSED,
@@ -226,6 +229,7 @@ var keywords = map[string]int{
 	"int4":                UNUSED,
 	"int8":                UNUSED,
 	"integer":             INTEGER,
+	"intersect":           INTERSECT,
 	"interval":            INTERVAL,
 	"into":                INTO,
 	"io_after_gtids":      UNUSED,
@@ -297,6 +301,7 @@ var keywords = map[string]int{
 	"order":               ORDER,
 	"out":                 UNUSED,
 	"outer":               OUTER,
+	"over":                OVER,
 	"outfile":             UNUSED,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
@@ -311,6 +316,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
@@ -328,6 +334,7 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"rollup":              ROLLUP,
 	"schema":              SCHEMA,
 	"schemas":             SCHEMAS,
 	"second_microsecond":  UNUSED,
@@ -337,6 +344,7 @@ var keywords = map[string]int{
 	"serializable":        SERIALIZABLE,
 	"session":             SESSION,
 	"set":                 SET,
+	"sets":                SETS,
 	"share":               SHARE,
 	"show":                SHOW,
 	"signal":              UNUSED,
@@ -50,6 +50,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 				Arguments: node.GroupBy.Key,
 			},
 		}, withTypeInfo))
+		if node.GroupBy.GroupingSets != nil {
+			out.AddField("grouping_sets", fmt.Sprint(node.GroupBy.GroupingSets))
+		}
 		out.AddChild("source", ExplainNode(node.GroupBy.Source, withTypeInfo))
 
 	case NodeTypeStreamJoin:
@@ -68,6 +71,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 				Arguments: node.StreamJoin.LeftKey,
 			},
 		}, withTypeInfo))
+		if node.StreamJoin.TimeBound != nil {
+			out.AddField("time_bound", node.StreamJoin.TimeBound.String())
+		}
 
 	case NodeTypeLookupJoin:
 		out = graph.NewNode("lookup join")
@@ -83,6 +89,11 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 
 		out.AddChild("source", ExplainNode(node.Map.Source, withTypeInfo))
 
+	case NodeTypeUnionAll:
+		out = graph.NewNode("union all")
+		out.AddChild("left", ExplainNode(node.UnionAll.Left, withTypeInfo))
+		out.AddChild("right", ExplainNode(node.UnionAll.Right, withTypeInfo))
+
 	case NodeTypeRequalifier:
 		out = graph.NewNode("requalifier")
 		out.AddField("new qualifier", node.Requalifier.Qualifier)
@@ -134,6 +145,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 		}, withTypeInfo))
 		out.AddField("is_left_outer", fmt.Sprint(node.OuterJoin.IsLeft))
 		out.AddField("is_right_outer", fmt.Sprint(node.OuterJoin.IsRight))
+		if node.OuterJoin.TimeBound != nil {
+			out.AddField("time_bound", node.OuterJoin.TimeBound.String())
+		}
 
 	case NodeTypeOrderSensitiveTransform:
 		out = graph.NewNode("sort")
@@ -151,9 +165,74 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 			prev := out
 			out = graph.NewNode("limit")
 			out.AddChild("limit", ExplainExpr(*node.OrderSensitiveTransform.Limit, withTypeInfo))
+			if node.OrderSensitiveTransform.Offset != nil {
+				out.AddChild("offset", ExplainExpr(*node.OrderSensitiveTransform.Offset, withTypeInfo))
+			}
 			out.AddChild("source", prev)
 		}
 
+	case NodeTypeWindow:
+		out = graph.NewNode("window")
+		for _, function := range node.Window.Functions {
+			out.AddChild(function.Name, ExplainExpr(Expression{
+				ExpressionType: ExpressionTypeTuple,
+				Tuple: &Tuple{
+					Arguments: function.Arguments,
+				},
+			}, withTypeInfo))
+		}
+		out.AddChild("partition_by", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.Window.PartitionBy,
+			},
+		}, withTypeInfo))
+		for i := range node.Window.OrderByKey {
+			if node.Window.OrderByDirectionMultipliers[i] == 1 {
+				out.AddChild("asc", ExplainExpr(node.Window.OrderByKey[i], withTypeInfo))
+			} else {
+				out.AddChild("desc", ExplainExpr(node.Window.OrderByKey[i], withTypeInfo))
+			}
+		}
+		out.AddChild("source", ExplainNode(node.Window.Source, withTypeInfo))
+
+	case NodeTypeRecursiveCTE:
+		out = graph.NewNode("recursive_cte")
+		out.AddField("name", node.RecursiveCTE.Name)
+		out.AddField("distinct", fmt.Sprint(node.RecursiveCTE.Distinct))
+		out.AddChild("anchor", ExplainNode(node.RecursiveCTE.Anchor, withTypeInfo))
+		out.AddChild("recursive", ExplainNode(node.RecursiveCTE.Recursive, withTypeInfo))
+
+	case NodeTypeRecursiveCTEReference:
+		out = graph.NewNode("recursive_cte_reference")
+		out.AddField("name", node.RecursiveCTEReference.Name)
+
+	case NodeTypeSetOperation:
+		out = graph.NewNode(node.SetOperation.Type.String())
+		out.AddChild("left", ExplainNode(node.SetOperation.Left, withTypeInfo))
+		out.AddChild("right", ExplainNode(node.SetOperation.Right, withTypeInfo))
+
+	case NodeTypeSemiJoin:
+		if node.SemiJoin.IsAnti {
+			out = graph.NewNode("anti join")
+		} else {
+			out = graph.NewNode("semi join")
+		}
+		out.AddChild("right", ExplainNode(node.SemiJoin.Right, withTypeInfo))
+		out.AddChild("left", ExplainNode(node.SemiJoin.Left, withTypeInfo))
+		out.AddChild("right_key", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.SemiJoin.RightKey,
+			},
+		}, withTypeInfo))
+		out.AddChild("left_key", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.SemiJoin.LeftKey,
+			},
+		}, withTypeInfo))
+
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -206,6 +285,9 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 
 	case ExpressionTypeQueryExpression:
 		out = graph.NewNode("subquery")
+		if expr.QueryExpression.Mode != QueryExpressionModeList {
+			out.AddField("mode", expr.QueryExpression.Mode.String())
+		}
 		out.AddChild("source", ExplainNode(expr.QueryExpression.Source, withTypeInfo))
 
 	case ExpressionTypeCoalesce:
@@ -235,6 +317,14 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 		out.AddChild("object", ExplainExpr(expr.ObjectFieldAccess.Object, withTypeInfo))
 		out.AddField("field", expr.ObjectFieldAccess.Field)
 
+	case ExpressionTypeCase:
+		out = graph.NewNode("case")
+		for i := range expr.Case.Conditions {
+			out.AddChild(fmt.Sprintf("when_%d", i), ExplainExpr(expr.Case.Conditions[i], withTypeInfo))
+			out.AddChild(fmt.Sprintf("then_%d", i), ExplainExpr(expr.Case.Results[i], withTypeInfo))
+		}
+		out.AddChild("else", ExplainExpr(expr.Case.Else, withTypeInfo))
+
 	default:
 		panic("unexhaustive expression type match")
 	}
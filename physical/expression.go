@@ -25,6 +25,7 @@ type Expression struct {
 	TypeAssertion     *TypeAssertion
 	TypeCast          *TypeCast
 	ObjectFieldAccess *ObjectFieldAccess
+	Case              *Case
 }
 
 type ExpressionType int
@@ -41,6 +42,7 @@ const (
 	ExpressionTypeTypeAssertion
 	ExpressionTypeTypeCast
 	ExpressionTypeObjectFieldAccess
+	ExpressionTypeCase
 )
 
 func (t ExpressionType) String() string {
@@ -67,6 +69,8 @@ func (t ExpressionType) String() string {
 		return "cast"
 	case ExpressionTypeObjectFieldAccess:
 		return "object_field_access"
+	case ExpressionTypeCase:
+		return "case"
 	}
 	return "unknown"
 }
@@ -96,6 +100,30 @@ type Or struct {
 
 type QueryExpression struct {
 	Source Node
+	Mode   QueryExpressionMode
+}
+
+type QueryExpressionMode int
+
+const (
+	// QueryExpressionModeList returns all the records of the subquery as a list.
+	QueryExpressionModeList QueryExpressionMode = iota
+	// QueryExpressionModeExists returns whether the subquery returns any records.
+	QueryExpressionModeExists
+	// QueryExpressionModeNotExists returns whether the subquery returns no records.
+	QueryExpressionModeNotExists
+)
+
+func (m QueryExpressionMode) String() string {
+	switch m {
+	case QueryExpressionModeList:
+		return "list"
+	case QueryExpressionModeExists:
+		return "exists"
+	case QueryExpressionModeNotExists:
+		return "not exists"
+	}
+	panic("unexhaustive query expression mode match")
 }
 
 type Coalesce struct {
@@ -121,6 +149,12 @@ type ObjectFieldAccess struct {
 	Field  string
 }
 
+type Case struct {
+	Conditions []Expression
+	Results    []Expression
+	Else       Expression
+}
+
 func (expr *Expression) Materialize(ctx context.Context, env Environment) (execution.Expression, error) {
 	switch expr.ExpressionType {
 	case ExpressionTypeVariable:
@@ -183,6 +217,15 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		if err != nil {
 			return nil, fmt.Errorf("couldn't materialize query expression source: %w", err)
 		}
+		switch expr.QueryExpression.Mode {
+		case QueryExpressionModeExists, QueryExpressionModeNotExists:
+			return execution.NewExistsQueryExpression(
+				source,
+				expr.QueryExpression.Mode == QueryExpressionModeNotExists,
+				expr.QueryExpression.Source.Schema.NoRetractions,
+			), nil
+		}
+
 		singleColumn := false
 		if len(expr.QueryExpression.Source.Schema.Fields) == 1 {
 			singleColumn = true
@@ -263,6 +306,34 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		}
 
 		return execution.NewObjectFieldAccess(object, fieldIndex), nil
+	case ExpressionTypeCase:
+		conditions := make([]execution.Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			expression, err := expr.Case.Conditions[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE condition with index %d: %w", i, err)
+			}
+			conditions[i] = expression
+		}
+		results := make([]execution.Expression, len(expr.Case.Results))
+		for i := range expr.Case.Results {
+			expression, err := expr.Case.Results[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE result with index %d: %w", i, err)
+			}
+			results[i] = expression
+		}
+		elseResult, err := expr.Case.Else.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize CASE else result: %w", err)
+		}
+		sourceTypes := make([]octosql.Type, len(expr.Case.Results)+1)
+		for i := range expr.Case.Results {
+			sourceTypes[i] = expr.Case.Results[i].Type
+		}
+		sourceTypes[len(expr.Case.Results)] = expr.Case.Else.Type
+
+		return execution.NewCase(conditions, results, elseResult, execution.NewObjectLayoutFixer(expr.Type, sourceTypes)), nil
 	}
 
 	panic("unexhaustive expression type match")
@@ -329,6 +400,13 @@ func (expr Expression) variablesUsed(acc map[string]struct{}) {
 	case ExpressionTypeTypeCast:
 		expr.TypeCast.Expression.variablesUsed(acc)
 		return
+	case ExpressionTypeCase:
+		for i := range expr.Case.Conditions {
+			expr.Case.Conditions[i].variablesUsed(acc)
+			expr.Case.Results[i].variablesUsed(acc)
+		}
+		expr.Case.Else.variablesUsed(acc)
+		return
 	}
 
 	panic("unexhaustive expression type match")
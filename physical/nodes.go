@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/nodes"
@@ -22,12 +23,18 @@ type Node struct {
 	LookupJoin              *LookupJoin
 	StreamJoin              *StreamJoin
 	Map                     *Map
+	UnionAll                *UnionAll
 	Requalifier             *Requalifier
 	TableValuedFunction     *TableValuedFunction
 	Unnest                  *Unnest
 	InMemoryRecords         *InMemoryRecords
 	OuterJoin               *OuterJoin
 	OrderSensitiveTransform *OrderSensitiveTransform
+	Window                  *Window
+	RecursiveCTE            *RecursiveCTE
+	RecursiveCTEReference   *RecursiveCTEReference
+	SemiJoin                *SemiJoin
+	SetOperation            *SetOperation
 }
 
 type Schema struct {
@@ -71,7 +78,7 @@ const (
 	NodeTypeLookupJoin
 	NodeTypeStreamJoin
 	NodeTypeMap
-	_
+	NodeTypeUnionAll
 	NodeTypeRequalifier
 	NodeTypeTableValuedFunction
 	NodeTypeUnnest
@@ -79,6 +86,11 @@ const (
 	NodeTypeInMemoryRecords
 	NodeTypeOuterJoin
 	NodeTypeOrderSensitiveTransform
+	NodeTypeWindow
+	NodeTypeRecursiveCTE
+	NodeTypeRecursiveCTEReference
+	NodeTypeSemiJoin
+	NodeTypeSetOperation
 )
 
 func (t NodeType) String() string {
@@ -97,6 +109,8 @@ func (t NodeType) String() string {
 		return "stream_join"
 	case NodeTypeMap:
 		return "map"
+	case NodeTypeUnionAll:
+		return "union_all"
 	case NodeTypeRequalifier:
 		return "requalifier"
 	case NodeTypeTableValuedFunction:
@@ -109,6 +123,16 @@ func (t NodeType) String() string {
 		return "outer_join"
 	case NodeTypeOrderSensitiveTransform:
 		return "order_sensitive_transform"
+	case NodeTypeWindow:
+		return "window"
+	case NodeTypeRecursiveCTE:
+		return "recursive_cte"
+	case NodeTypeRecursiveCTEReference:
+		return "recursive_cte_reference"
+	case NodeTypeSemiJoin:
+		return "semi_join"
+	case NodeTypeSetOperation:
+		return "set_operation"
 	}
 	return "unknown"
 }
@@ -178,6 +202,18 @@ type GroupBy struct {
 	// Either index to Key, or -1, if none.
 	KeyEventTimeIndex int
 	Trigger           Trigger
+	// GroupingSets lists the indices of the Key expressions in each grouping set, or is nil if there's a single one.
+	// With grouping sets, the key fields are followed by a field holding the index of the grouping set of each record,
+	// and key fields which aren't part of a record's grouping set are NULL.
+	GroupingSets [][]int
+}
+
+// KeyFieldCount returns the number of output fields preceding the aggregates.
+func (node *GroupBy) KeyFieldCount() int {
+	if node.GroupingSets != nil {
+		return len(node.Key) + 1
+	}
+	return len(node.Key)
 }
 
 type Aggregate struct {
@@ -189,6 +225,65 @@ type Aggregate struct {
 type StreamJoin struct {
 	Left, Right       Node
 	LeftKey, RightKey []Expression
+	// TimeBound is set if the join predicate bounds the difference between the time fields of both sides.
+	TimeBound *JoinTimeBound
+}
+
+// CanUseHashJoin returns whether the join can be run as a hash join,
+// which doesn't need to keep its state ordered, because neither input has retractions or a time field.
+func (node *StreamJoin) CanUseHashJoin() bool {
+	return node.TimeBound == nil &&
+		node.Left.Schema.NoRetractions && node.Left.Schema.TimeField == -1 &&
+		node.Right.Schema.NoRetractions && node.Right.Schema.TimeField == -1
+}
+
+// JoinTimeBound limits the difference between the time field of the left record and the time field of the right record of a join,
+// so that records which can't be joined with any future records can be evicted from the join state.
+// A nil limit means the difference is unbounded in that direction.
+type JoinTimeBound struct {
+	Lower, Upper *JoinTimeBoundLimit
+}
+
+type JoinTimeBoundLimit struct {
+	Difference time.Duration
+	Inclusive  bool
+}
+
+func (bound *JoinTimeBound) String() string {
+	var parts []string
+	if bound.Lower != nil {
+		operator := "<"
+		if bound.Lower.Inclusive {
+			operator = "<="
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", bound.Lower.Difference, operator))
+	}
+	parts = append(parts, "left - right")
+	if bound.Upper != nil {
+		operator := "<"
+		if bound.Upper.Inclusive {
+			operator = "<="
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", operator, bound.Upper.Difference))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (bound *JoinTimeBound) Materialize(leftSchema, rightSchema Schema) *nodes.JoinTimeBound {
+	if bound == nil {
+		return nil
+	}
+	out := &nodes.JoinTimeBound{
+		LeftTimeFieldIndex:  leftSchema.TimeField,
+		RightTimeFieldIndex: rightSchema.TimeField,
+	}
+	if bound.Lower != nil {
+		out.Lower = &nodes.JoinTimeBoundLimit{Difference: bound.Lower.Difference, Inclusive: bound.Lower.Inclusive}
+	}
+	if bound.Upper != nil {
+		out.Upper = &nodes.JoinTimeBoundLimit{Difference: bound.Upper.Difference, Inclusive: bound.Upper.Inclusive}
+	}
+	return out
 }
 
 type LookupJoin struct {
@@ -200,6 +295,10 @@ type Map struct {
 	Expressions []Expression
 }
 
+type UnionAll struct {
+	Left, Right Node
+}
+
 type Requalifier struct {
 	Source    Node
 	Qualifier string
@@ -268,6 +367,8 @@ type OuterJoin struct {
 	Left, Right       Node
 	LeftKey, RightKey []Expression
 	IsLeft, IsRight   bool // Full Outer Join will have both true.
+	// TimeBound is set if the join predicate bounds the difference between the time fields of both sides.
+	TimeBound *JoinTimeBound
 }
 
 type OrderSensitiveTransform struct {
@@ -275,6 +376,64 @@ type OrderSensitiveTransform struct {
 	OrderByKey                  []Expression
 	OrderByDirectionMultipliers []int
 	Limit                       *Expression
+	Offset                      *Expression
+}
+
+type Window struct {
+	Source                      Node
+	PartitionBy                 []Expression
+	OrderByKey                  []Expression
+	OrderByDirectionMultipliers []int
+	Functions                   []WindowFunction
+}
+
+type WindowFunction struct {
+	Name      string
+	Arguments []Expression
+	// Aggregate is only set for aggregates used as window functions.
+	Aggregate *Aggregate
+}
+
+type RecursiveCTE struct {
+	// Name identifies the working table read by the references in the recursive part.
+	Name              string
+	Anchor, Recursive Node
+	Distinct          bool
+}
+
+type RecursiveCTEReference struct {
+	Name string
+}
+
+// SemiJoin passes through the records of the left side which have a matching key on the right side.
+// If IsAnti is set, it instead passes through the ones which don't, following the semantics of NOT IN.
+type SemiJoin struct {
+	Left, Right       Node
+	LeftKey, RightKey []Expression
+	IsAnti            bool
+}
+
+// SetOperation is a multiset operation, matching records of both sources by value.
+type SetOperation struct {
+	Left, Right Node
+	Type        SetOperationType
+}
+
+type SetOperationType int
+
+const (
+	SetOperationTypeIntersectAll SetOperationType = iota
+	SetOperationTypeExceptAll
+)
+
+func (t SetOperationType) String() string {
+	switch t {
+	case SetOperationTypeIntersectAll:
+		return "intersect all"
+	case SetOperationTypeExceptAll:
+		return "except all"
+	}
+	return "unknown"
 }
 
 func (node *Node) Materialize(ctx context.Context, env Environment) (execution.Node, error) {
@@ -301,7 +460,7 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		if err != nil {
 			return nil, fmt.Errorf("couldn't materialize distinct source: %w", err)
 		}
-		return nodes.NewDistinct(source), nil
+		return nodes.NewDistinct(source, env.MemoryBudget), nil
 	case NodeTypeFilter:
 		source, err := node.Filter.Source.Materialize(ctx, env)
 		if err != nil {
@@ -337,12 +496,44 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			}
 			expressions[i] = expr
 		}
-		if node.GroupBy.Trigger.TriggerType == TriggerTypeEndOfStream {
-			return nodes.NewSimpleGroupBy(aggregates, expressions, key, source), nil
+		memoryBudget := env.MemoryBudget
+		newGroupBy := func(source execution.Node) execution.Node {
+			if node.GroupBy.Trigger.TriggerType == TriggerTypeEndOfStream {
+				return nodes.NewSimpleGroupBy(aggregates, expressions, key, node.GroupBy.GroupingSets, memoryBudget, source)
+			}
+			trigger := node.GroupBy.Trigger.Materialize(ctx, env)
+
+			return nodes.NewCustomTriggerGroupBy(aggregates, expressions, key, node.GroupBy.GroupingSets, node.GroupBy.KeyEventTimeIndex, env.LateRecords, memoryBudget, source, trigger)
 		}
-		trigger := node.GroupBy.Trigger.Materialize(ctx, env)
 
-		return nodes.NewCustomTriggerGroupBy(aggregates, expressions, key, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
+		// Each key is aggregated separately, so the records can be partitioned by the key fields which are part of every grouping set.
+		var partitionKey []execution.Expression
+		for i := range key {
+			inAllGroupingSets := true
+			for _, groupingSet := range node.GroupBy.GroupingSets {
+				inGroupingSet := false
+				for _, keyIndex := range groupingSet {
+					if keyIndex == i {
+						inGroupingSet = true
+						break
+					}
+				}
+				if !inGroupingSet {
+					inAllGroupingSets = false
+					break
+				}
+			}
+			if inAllGroupingSets {
+				partitionKey = append(partitionKey, key[i])
+			}
+		}
+		if env.Parallelism <= 1 || len(partitionKey) == 0 {
+			return newGroupBy(source), nil
+		}
+
+		// The partitions share the memory budget.
+		memoryBudget /= env.Parallelism
+		return nodes.NewPartitioned(source, partitionKey, env.Parallelism, newGroupBy), nil
 	case NodeTypeStreamJoin:
 		left, err := node.StreamJoin.Left.Materialize(ctx, env)
 		if err != nil {
@@ -370,7 +561,14 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			rightKeyExprs[i] = expr
 		}
 
-		return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+		// The build side of a hash join is kept in memory, so it's only used without a memory budget.
+		if !env.DisableHashJoins && env.MemoryBudget == 0 && node.StreamJoin.CanUseHashJoin() {
+			return nodes.NewHashJoin(left, right, leftKeyExprs, rightKeyExprs, env.WatermarkIdleTimeout), nil
+		}
+
+		timeBound := node.StreamJoin.TimeBound.Materialize(node.StreamJoin.Left.Schema, node.StreamJoin.Right.Schema)
+
+		return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs, timeBound, env.LateRecords, env.MemoryBudget, env.WatermarkIdleTimeout), nil
 	case NodeTypeLookupJoin:
 		source, err := node.LookupJoin.Source.Materialize(ctx, env)
 		if err != nil {
@@ -381,7 +579,25 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			return nil, fmt.Errorf("couldn't materialize right join source: %w", err)
 		}
 
-		return nodes.NewLookupJoin(source, joined), nil
+		// The source fields the joined stream uses are the key of its cached outputs.
+		used := make(map[string]bool)
+		usageCollector := Transformers{
+			ExpressionTransformer: func(expr Expression) Expression {
+				if expr.ExpressionType == ExpressionTypeVariable {
+					used[expr.Variable.Name] = true
+				}
+				return expr
+			},
+		}
+		usageCollector.TransformNode(node.LookupJoin.Joined)
+		var correlatedFields []int
+		for i, field := range node.LookupJoin.Source.Schema.Fields {
+			if used[field.Name] {
+				correlatedFields = append(correlatedFields, i)
+			}
+		}
+
+		return nodes.NewLookupJoin(source, joined, correlatedFields, env.LookupJoinConcurrency, env.LookupJoinCacheSize, env.LookupJoinCacheTTL), nil
 	case NodeTypeMap:
 		source, err := node.Map.Source.Materialize(ctx, env)
 		if err != nil {
@@ -397,6 +613,25 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		}
 
 		return nodes.NewMap(source, expressions), nil
+	case NodeTypeUnionAll:
+		left, err := node.UnionAll.Left.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize left union source: %w", err)
+		}
+		right, err := node.UnionAll.Right.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize right union source: %w", err)
+		}
+
+		fieldLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			fieldLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.UnionAll.Left.Schema.Fields[i].Type,
+				node.UnionAll.Right.Schema.Fields[i].Type,
+			})
+		}
+
+		return nodes.NewUnionAll(left, right, fieldLayoutFixers, env.WatermarkIdleTimeout), nil
 	case NodeTypeRequalifier:
 		return node.Requalifier.Source.Materialize(ctx, env)
 
@@ -453,7 +688,9 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			rightKeyExprs[i] = expr
 		}
 
-		return nodes.NewOuterJoin(left, right, len(node.OuterJoin.Left.Schema.Fields), len(node.OuterJoin.Right.Schema.Fields), leftKeyExprs, rightKeyExprs, node.OuterJoin.IsLeft, node.OuterJoin.IsRight), nil
+		timeBound := node.OuterJoin.TimeBound.Materialize(node.OuterJoin.Left.Schema, node.OuterJoin.Right.Schema)
+
+		return nodes.NewOuterJoin(left, right, len(node.OuterJoin.Left.Schema.Fields), len(node.OuterJoin.Right.Schema.Fields), leftKeyExprs, rightKeyExprs, node.OuterJoin.IsLeft, node.OuterJoin.IsRight, timeBound, env.LateRecords, env.MemoryBudget, env.WatermarkIdleTimeout), nil
 
 	case NodeTypeOrderSensitiveTransform:
 		source, err := node.OrderSensitiveTransform.Source.Materialize(ctx, env)
@@ -476,17 +713,185 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			}
 			limit = &expr
 		}
+		var offset *execution.Expression
+		if node.OrderSensitiveTransform.Offset != nil {
+			expr, err := node.OrderSensitiveTransform.Offset.Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize offset expression: %w", err)
+			}
+			offset = &expr
+		}
 
 		if len(orderByKeyExprs) > 0 || (limit != nil && !node.OrderSensitiveTransform.Source.Schema.NoRetractions) {
-			return nodes.NewOrderSensitiveTransform(source, orderByKeyExprs, node.OrderSensitiveTransform.OrderByDirectionMultipliers, limit, node.OrderSensitiveTransform.Source.Schema.NoRetractions), nil
+			return nodes.NewOrderSensitiveTransform(source, orderByKeyExprs, node.OrderSensitiveTransform.OrderByDirectionMultipliers, limit, offset, node.OrderSensitiveTransform.Source.Schema.NoRetractions, env.MemoryBudget), nil
 		}
 
 		if limit != nil {
-			return nodes.NewLimit(source, *limit), nil
+			return nodes.NewLimit(source, *limit, offset), nil
 		}
 
 		// Probably shouldn't happen...
 		return source, nil
+
+	case NodeTypeWindow:
+		source, err := node.Window.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize window source: %w", err)
+		}
+		partitionBy := make([]execution.Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			expr, err := node.Window.PartitionBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize partition by expression with index %d: %w", i, err)
+			}
+			partitionBy[i] = expr
+		}
+		orderByKeyExprs := make([]execution.Expression, len(node.Window.OrderByKey))
+		for i := range node.Window.OrderByKey {
+			expr, err := node.Window.OrderByKey[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize order by key with index %d: %w", i, err)
+			}
+			orderByKeyExprs[i] = expr
+		}
+		functions := make([]nodes.WindowFunction, len(node.Window.Functions))
+		for i, function := range node.Window.Functions {
+			arguments := make([]execution.Expression, len(function.Arguments))
+			for j := range function.Arguments {
+				expr, err := function.Arguments[j].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize argument with index %d of window function %s: %w", j, function.Name, err)
+				}
+				arguments[j] = expr
+			}
+			functions[i].Arguments = arguments
+
+			if function.Aggregate != nil {
+				functions[i].Type = nodes.WindowFunctionTypeAggregate
+				functions[i].AggregatePrototype = function.Aggregate.AggregateDescriptor.Prototype
+				continue
+			}
+			switch function.Name {
+			case "row_number":
+				functions[i].Type = nodes.WindowFunctionTypeRowNumber
+			case "rank":
+				functions[i].Type = nodes.WindowFunctionTypeRank
+			case "dense_rank":
+				functions[i].Type = nodes.WindowFunctionTypeDenseRank
+			case "lag":
+				functions[i].Type = nodes.WindowFunctionTypeLag
+			case "lead":
+				functions[i].Type = nodes.WindowFunctionTypeLead
+			case "first_value":
+				functions[i].Type = nodes.WindowFunctionTypeFirstValue
+			case "last_value":
+				functions[i].Type = nodes.WindowFunctionTypeLastValue
+			default:
+				return nil, fmt.Errorf("unknown window function: %s", function.Name)
+			}
+		}
+
+		return nodes.NewWindow(source, partitionBy, orderByKeyExprs, node.Window.OrderByDirectionMultipliers, functions), nil
+
+	case NodeTypeRecursiveCTE:
+		anchor, err := node.RecursiveCTE.Anchor.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize recursive common table expression anchor part: %w", err)
+		}
+
+		workingTable := &nodes.RecursiveCTEWorkingTable{}
+		recursiveEnv := env
+		recursiveEnv.RecursiveCTEWorkingTables = make(map[string]*nodes.RecursiveCTEWorkingTable)
+		for k, v := range env.RecursiveCTEWorkingTables {
+			recursiveEnv.RecursiveCTEWorkingTables[k] = v
+		}
+		recursiveEnv.RecursiveCTEWorkingTables[node.RecursiveCTE.Name] = workingTable
+		recursive, err := node.RecursiveCTE.Recursive.Materialize(ctx, recursiveEnv)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize recursive common table expression recursive part: %w", err)
+		}
+
+		fieldLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			fieldLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.RecursiveCTE.Anchor.Schema.Fields[i].Type,
+				node.RecursiveCTE.Recursive.Schema.Fields[i].Type,
+			})
+		}
+
+		maxIterations := env.RecursiveCTEMaxIterations
+		if maxIterations == 0 {
+			maxIterations = DefaultRecursiveCTEMaxIterations
+		}
+
+		return nodes.NewRecursiveCTE(anchor, recursive, workingTable, node.RecursiveCTE.Distinct, maxIterations, fieldLayoutFixers), nil
+
+	case NodeTypeRecursiveCTEReference:
+		workingTable, ok := env.RecursiveCTEWorkingTables[node.RecursiveCTEReference.Name]
+		if !ok {
+			return nil, fmt.Errorf("no working table for recursive common table expression reference: %s", node.RecursiveCTEReference.Name)
+		}
+
+		return nodes.NewRecursiveCTEReference(workingTable), nil
+
+	case NodeTypeSemiJoin:
+		left, err := node.SemiJoin.Left.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize left semi join source: %w", err)
+		}
+		right, err := node.SemiJoin.Right.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize right semi join source: %w", err)
+		}
+
+		leftKeyExprs := make([]execution.Expression, len(node.SemiJoin.LeftKey))
+		for i := range node.SemiJoin.LeftKey {
+			expr, err := node.SemiJoin.LeftKey[i].Materialize(ctx, env.WithRecordSchema(node.SemiJoin.Left.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize semi join left key expression with index %d: %w", i, err)
+			}
+			leftKeyExprs[i] = expr
+		}
+		rightKeyExprs := make([]execution.Expression, len(node.SemiJoin.RightKey))
+		for i := range node.SemiJoin.RightKey {
+			expr, err := node.SemiJoin.RightKey[i].Materialize(ctx, env.WithRecordSchema(node.SemiJoin.Right.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize semi join right key expression with index %d: %w", i, err)
+			}
+			rightKeyExprs[i] = expr
+		}
+
+		return nodes.NewSemiJoin(left, right, leftKeyExprs, rightKeyExprs, node.SemiJoin.IsAnti, env.LateRecords, env.WatermarkIdleTimeout), nil
+
+	case NodeTypeSetOperation:
+		left, err := node.SetOperation.Left.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize left %s source: %w", node.SetOperation.Type, err)
+		}
+		right, err := node.SetOperation.Right.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize right %s source: %w", node.SetOperation.Type, err)
+		}
+
+		fieldLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			fieldLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.SetOperation.Left.Schema.Fields[i].Type,
+				node.SetOperation.Right.Schema.Fields[i].Type,
+			})
+		}
+
+		var setOperationType nodes.SetOperationType
+		switch node.SetOperation.Type {
+		case SetOperationTypeIntersectAll:
+			setOperationType = nodes.SetOperationTypeIntersectAll
+		case SetOperationTypeExceptAll:
+			setOperationType = nodes.SetOperationTypeExceptAll
+		default:
+			panic(fmt.Sprintf("invalid set operation type: %d", node.SetOperation.Type))
+		}
+
+		return nodes.NewSetOperation(left, right, setOperationType, fieldLayoutFixers, env.WatermarkIdleTimeout), nil
 	}
 
 	panic(fmt.Sprintf("unexhaustive node type match: %d", node.NodeType))
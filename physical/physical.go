@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/nodes"
@@ -17,8 +18,37 @@ type Environment struct {
 	Functions       map[string]FunctionDetails
 	PhysicalConfig  map[string]interface{}
 	VariableContext *VariableContext
+	// RecursiveCTEMaxIterations limits the number of iterations of recursive common table expressions.
+	// If zero, DefaultRecursiveCTEMaxIterations is used.
+	RecursiveCTEMaxIterations int
+	// RecursiveCTEWorkingTables holds the working tables of the recursive common table expressions being materialized.
+	RecursiveCTEWorkingTables map[string]*nodes.RecursiveCTEWorkingTable
+	// LateRecords handles records arriving behind the watermark, it holds the allowed lateness.
+	LateRecords *execution.LateRecords
+	// WatermarkIdleTimeout is how long an input of a node with multiple inputs may not send anything before it stops holding back the watermark.
+	// Disabled if zero.
+	WatermarkIdleTimeout time.Duration
+	// MemoryBudget is how much memory the state of a single group by, distinct, stream join, outer join or order by may use before getting spilled to disk.
+	// Hash joins aren't used if it's set, as they keep their build side in memory.
+	// Disabled if zero.
+	MemoryBudget int
+	// LookupJoinConcurrency is the maximum number of joined streams a lookup join runs at the same time.
+	// Runs them one by one if at most one.
+	LookupJoinConcurrency int
+	// LookupJoinCacheSize is how much memory a lookup join may use to cache the outputs of its joined stream.
+	// Disabled if zero.
+	LookupJoinCacheSize int
+	// LookupJoinCacheTTL is how long the cached outputs of joined streams are valid. They never expire if zero.
+	LookupJoinCacheTTL time.Duration
+	// DisableHashJoins makes joins of inputs with no retractions and no time field use the stream join, instead of the hash join.
+	DisableHashJoins bool
+	// Parallelism is the number of partitions group bys are run with in parallel.
+	// Runs them in a single goroutine if at most one.
+	Parallelism int
 }
 
+const DefaultRecursiveCTEMaxIterations = 1000
+
 func (env Environment) WithRecordSchema(schema Schema) Environment {
 	newEnv := env
 	newEnv.VariableContext = newEnv.VariableContext.WithRecordSchema(schema)
@@ -72,6 +72,7 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Key:                  key,
 				KeyEventTimeIndex:    node.GroupBy.KeyEventTimeIndex,
 				Trigger:              node.GroupBy.Trigger,
+				GroupingSets:         node.GroupBy.GroupingSets,
 			},
 		}
 	case NodeTypeStreamJoin:
@@ -88,10 +89,11 @@ func (t *Transformers) TransformNode(node Node) Node {
 			Schema:   schema,
 			NodeType: node.NodeType,
 			StreamJoin: &StreamJoin{
-				Left:     t.TransformNode(node.StreamJoin.Left),
-				Right:    t.TransformNode(node.StreamJoin.Right),
-				LeftKey:  leftKey,
-				RightKey: rightKey,
+				Left:      t.TransformNode(node.StreamJoin.Left),
+				Right:     t.TransformNode(node.StreamJoin.Right),
+				LeftKey:   leftKey,
+				RightKey:  rightKey,
+				TimeBound: node.StreamJoin.TimeBound,
 			},
 		}
 	case NodeTypeLookupJoin:
@@ -182,6 +184,15 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Records: node.InMemoryRecords.Records,
 			},
 		}
+	case NodeTypeUnionAll:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			UnionAll: &UnionAll{
+				Left:  t.TransformNode(node.UnionAll.Left),
+				Right: t.TransformNode(node.UnionAll.Right),
+			},
+		}
 	case NodeTypeOuterJoin:
 		leftKey := make([]Expression, len(node.OuterJoin.LeftKey))
 		for i := range node.OuterJoin.LeftKey {
@@ -196,12 +207,13 @@ func (t *Transformers) TransformNode(node Node) Node {
 			Schema:   schema,
 			NodeType: node.NodeType,
 			OuterJoin: &OuterJoin{
-				Left:     t.TransformNode(node.OuterJoin.Left),
-				Right:    t.TransformNode(node.OuterJoin.Right),
-				LeftKey:  leftKey,
-				RightKey: rightKey,
-				IsLeft:   node.OuterJoin.IsLeft,
-				IsRight:  node.OuterJoin.IsRight,
+				Left:      t.TransformNode(node.OuterJoin.Left),
+				Right:     t.TransformNode(node.OuterJoin.Right),
+				LeftKey:   leftKey,
+				RightKey:  rightKey,
+				IsLeft:    node.OuterJoin.IsLeft,
+				IsRight:   node.OuterJoin.IsRight,
+				TimeBound: node.OuterJoin.TimeBound,
 			},
 		}
 
@@ -218,6 +230,11 @@ func (t *Transformers) TransformNode(node Node) Node {
 			expr := t.TransformExpr(*node.OrderSensitiveTransform.Limit)
 			limit = &expr
 		}
+		var offset *Expression
+		if node.OrderSensitiveTransform.Offset != nil {
+			expr := t.TransformExpr(*node.OrderSensitiveTransform.Offset)
+			offset = &expr
+		}
 
 		out = Node{
 			Schema:   schema,
@@ -227,8 +244,100 @@ func (t *Transformers) TransformNode(node Node) Node {
 				OrderByKey:                  orderByKeyExprs,
 				OrderByDirectionMultipliers: orderByDirectionMultipliers,
 				Limit:                       limit,
+				Offset:                      offset,
 			},
 		}
+
+	case NodeTypeWindow:
+		partitionBy := make([]Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			partitionBy[i] = t.TransformExpr(node.Window.PartitionBy[i])
+		}
+		orderByKeyExprs := make([]Expression, len(node.Window.OrderByKey))
+		for i := range node.Window.OrderByKey {
+			orderByKeyExprs[i] = t.TransformExpr(node.Window.OrderByKey[i])
+		}
+		orderByDirectionMultipliers := make([]int, len(node.Window.OrderByDirectionMultipliers))
+		copy(orderByDirectionMultipliers, node.Window.OrderByDirectionMultipliers)
+		functions := make([]WindowFunction, len(node.Window.Functions))
+		for i, function := range node.Window.Functions {
+			arguments := make([]Expression, len(function.Arguments))
+			for j := range function.Arguments {
+				arguments[j] = t.TransformExpr(function.Arguments[j])
+			}
+			functions[i] = WindowFunction{
+				Name:      function.Name,
+				Arguments: arguments,
+				Aggregate: function.Aggregate,
+			}
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			Window: &Window{
+				Source:                      t.TransformNode(node.Window.Source),
+				PartitionBy:                 partitionBy,
+				OrderByKey:                  orderByKeyExprs,
+				OrderByDirectionMultipliers: orderByDirectionMultipliers,
+				Functions:                   functions,
+			},
+		}
+
+	case NodeTypeRecursiveCTE:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			RecursiveCTE: &RecursiveCTE{
+				Name:      node.RecursiveCTE.Name,
+				Anchor:    t.TransformNode(node.RecursiveCTE.Anchor),
+				Recursive: t.TransformNode(node.RecursiveCTE.Recursive),
+				Distinct:  node.RecursiveCTE.Distinct,
+			},
+		}
+
+	case NodeTypeRecursiveCTEReference:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			RecursiveCTEReference: &RecursiveCTEReference{
+				Name: node.RecursiveCTEReference.Name,
+			},
+		}
+
+	case NodeTypeSemiJoin:
+		leftKey := make([]Expression, len(node.SemiJoin.LeftKey))
+		for i := range node.SemiJoin.LeftKey {
+			leftKey[i] = t.TransformExpr(node.SemiJoin.LeftKey[i])
+		}
+		rightKey := make([]Expression, len(node.SemiJoin.RightKey))
+		for i := range node.SemiJoin.RightKey {
+			rightKey[i] = t.TransformExpr(node.SemiJoin.RightKey[i])
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			SemiJoin: &SemiJoin{
+				Left:     t.TransformNode(node.SemiJoin.Left),
+				Right:    t.TransformNode(node.SemiJoin.Right),
+				LeftKey:  leftKey,
+				RightKey: rightKey,
+				IsAnti:   node.SemiJoin.IsAnti,
+			},
+		}
+
+	case NodeTypeSetOperation:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			SetOperation: &SetOperation{
+				Left:  t.TransformNode(node.SetOperation.Left),
+				Right: t.TransformNode(node.SetOperation.Right),
+				Type:  node.SetOperation.Type,
+			},
+		}
+
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -307,6 +416,7 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 			ExpressionType: expr.ExpressionType,
 			QueryExpression: &QueryExpression{
 				Source: t.TransformNode(expr.QueryExpression.Source),
+				Mode:   expr.QueryExpression.Mode,
 			},
 		}
 	case ExpressionTypeCoalesce:
@@ -362,6 +472,25 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				Field:  expr.ObjectFieldAccess.Field,
 			},
 		}
+	case ExpressionTypeCase:
+		conditions := make([]Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			conditions[i] = t.TransformExpr(expr.Case.Conditions[i])
+		}
+		results := make([]Expression, len(expr.Case.Results))
+		for i := range expr.Case.Results {
+			results[i] = t.TransformExpr(expr.Case.Results[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Case: &Case{
+				Conditions: conditions,
+				Results:    results,
+				Else:       t.TransformExpr(expr.Case.Else),
+			},
+		}
 	default:
 		panic("unexhaustive expression type match")
 	}
@@ -2,6 +2,7 @@ package physical
 
 import (
 	"context"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 )
@@ -12,6 +13,7 @@ type Trigger struct {
 	CountingTrigger    *CountingTrigger
 	EndOfStreamTrigger *EndOfStreamTrigger
 	WatermarkTrigger   *WatermarkTrigger
+	DelayTrigger       *DelayTrigger
 	MultiTrigger       *MultiTrigger
 }
 
@@ -22,6 +24,7 @@ const (
 	TriggerTypeEndOfStream
 	TriggerTypeWatermark
 	TriggerTypeMulti
+	TriggerTypeDelay
 )
 
 func (t TriggerType) String() string {
@@ -34,6 +37,8 @@ func (t TriggerType) String() string {
 		return "watermark"
 	case TriggerTypeMulti:
 		return "multi"
+	case TriggerTypeDelay:
+		return "delay"
 	}
 	return "unknown"
 }
@@ -49,6 +54,10 @@ type WatermarkTrigger struct {
 	TimeFieldIndex int
 }
 
+type DelayTrigger struct {
+	Delay time.Duration
+}
+
 type MultiTrigger struct {
 	Triggers []Trigger
 }
@@ -61,6 +70,8 @@ func (t *Trigger) Materialize(ctx context.Context, env Environment) func() execu
 		return execution.NewEndOfStreamTriggerPrototype()
 	case TriggerTypeWatermark:
 		return execution.NewWatermarkTriggerPrototype(t.WatermarkTrigger.TimeFieldIndex)
+	case TriggerTypeDelay:
+		return execution.NewDelayTriggerPrototype(t.DelayTrigger.Delay)
 	case TriggerTypeMulti:
 		prototypes := make([]func() execution.Trigger, len(t.MultiTrigger.Triggers))
 		for i := range t.MultiTrigger.Triggers {
@@ -76,7 +87,7 @@ func (t *Trigger) Materialize(ctx context.Context, env Environment) func() execu
 // In other words, if a single key can be triggered multiple times.
 func (t *Trigger) NoRetractions() bool {
 	switch t.TriggerType {
-	case TriggerTypeCounting, TriggerTypeMulti:
+	case TriggerTypeCounting, TriggerTypeDelay, TriggerTypeMulti:
 		return false
 	case TriggerTypeEndOfStream, TriggerTypeWatermark:
 		return true
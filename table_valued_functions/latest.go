@@ -0,0 +1,186 @@
+package table_valued_functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/logical"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var Latest = logical.TableValuedFunctionDescription{
+	TypecheckArguments: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionArgumentValue) map[string]logical.TableValuedFunctionTypecheckedArgument {
+		outArgs := make(map[string]logical.TableValuedFunctionTypecheckedArgument)
+
+		source, mapping := args["source"].(*logical.TableValuedFunctionArgumentValueTable).
+			Typecheck(ctx, env, logicalEnv)
+		outArgs["source"] = logical.TableValuedFunctionTypecheckedArgument{Mapping: mapping, Argument: source}
+
+		outArgs["key"] = logical.TableValuedFunctionTypecheckedArgument{
+			Argument: args["key"].(*logical.TableValuedFunctionArgumentValueDescriptor).
+				Typecheck(ctx, env, logicalEnv.WithRecordUniqueVariableNames(mapping)),
+		}
+		if _, ok := args["time_field"]; ok {
+			outArgs["time_field"] = logical.TableValuedFunctionTypecheckedArgument{
+				Argument: args["time_field"].(*logical.TableValuedFunctionArgumentValueDescriptor).
+					Typecheck(ctx, env, logicalEnv.WithRecordUniqueVariableNames(mapping)),
+			}
+		}
+
+		return outArgs
+	},
+	Descriptors: []logical.TableValuedFunctionDescriptor{
+		{
+			Arguments: map[string]logical.TableValuedFunctionArgumentMatcher{
+				"source": {
+					Required:                               true,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeTable,
+					Table:                                  &logical.TableValuedFunctionArgumentMatcherTable{},
+				},
+				"key": {
+					Required:                               true,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeDescriptor,
+					Descriptor:                             &logical.TableValuedFunctionArgumentMatcherDescriptor{},
+				},
+				"time_field": {
+					Required:                               false,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeDescriptor,
+					Descriptor:                             &logical.TableValuedFunctionArgumentMatcherDescriptor{},
+				},
+			},
+			OutputSchema: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionTypecheckedArgument) (physical.Schema, map[string]string, error) {
+				source := args["source"].Argument.Table.Table
+				keyField := args["key"].Argument.Descriptor.Descriptor
+				found := false
+				for _, field := range source.Schema.Fields {
+					if field.Name == keyField {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return physical.Schema{}, nil, fmt.Errorf("no %s field in source stream", keyField)
+				}
+				if timeFieldDescriptor, ok := args["time_field"]; ok {
+					timeField := timeFieldDescriptor.Argument.Descriptor.Descriptor
+					found := false
+					for _, field := range source.Schema.Fields {
+						if field.Name != timeField {
+							continue
+						}
+						if field.Type.TypeID != octosql.TypeIDTime {
+							return physical.Schema{}, nil, fmt.Errorf("time_field must reference Time typed field, is %s", field.Type.String())
+						}
+						found = true
+						break
+					}
+					if !found {
+						return physical.Schema{}, nil, fmt.Errorf("no %s field in source stream", timeField)
+					}
+				} else {
+					if source.Schema.TimeField == -1 {
+						return physical.Schema{}, nil, fmt.Errorf("the source table has no implicit watermarked time field, time_field must be specified explicitly")
+					}
+				}
+				return physical.Schema{
+					Fields:    source.Schema.Fields,
+					TimeField: source.Schema.TimeField,
+					// Previous versions of a key get retracted when a newer one arrives.
+					NoRetractions: false,
+				}, args["source"].Mapping, nil
+			},
+			Materialize: func(ctx context.Context, env physical.Environment, args map[string]physical.TableValuedFunctionArgument) (execution.Node, error) {
+				source, err := args["source"].Table.Table.Materialize(ctx, env)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize source table: %w", err)
+				}
+				var timeFieldIndex int
+				if timeFieldDescriptor, ok := args["time_field"]; ok {
+					timeField := timeFieldDescriptor.Descriptor.Descriptor
+					for i, field := range args["source"].Table.Table.Schema.Fields {
+						if field.Name == timeField {
+							timeFieldIndex = i
+							break
+						}
+					}
+				} else {
+					timeFieldIndex = args["source"].Table.Table.Schema.TimeField
+				}
+				var keyFieldIndex int
+				keyField := args["key"].Descriptor.Descriptor
+				for i, field := range args["source"].Table.Table.Schema.Fields {
+					if field.Name == keyField {
+						keyFieldIndex = i
+						break
+					}
+				}
+
+				return &latest{
+					source:         source,
+					keyFieldIndex:  keyFieldIndex,
+					timeFieldIndex: timeFieldIndex,
+				}, nil
+			},
+		},
+	},
+}
+
+// latest keeps the newest record for each key, turning an append-only stream into a changelog.
+// When a newer record for a key arrives, the previous one gets retracted.
+// Records older than the current one for their key are ignored.
+// Records with the same time replace each other in arrival order.
+// The state is kept for all keys, as any of them may get updated later.
+type latest struct {
+	source         execution.Node
+	keyFieldIndex  int
+	timeFieldIndex int
+}
+
+type latestKeyItem struct {
+	key    execution.GroupKey
+	values []octosql.Value
+}
+
+func (l *latest) Run(ctx execution.ExecutionContext, produce execution.ProduceFn, metaSend execution.MetaSendFn) error {
+	keys := btree.NewGenericOptions(func(a, b *latestKeyItem) bool {
+		return execution.CompareValueSlices(a.key, b.key)
+	}, btree.Options{
+		NoLocks: true,
+	})
+
+	if err := l.source.Run(ctx, func(produceCtx execution.ProduceContext, record execution.Record) error {
+		if record.Retraction {
+			return fmt.Errorf("latest doesn't support retractions")
+		}
+
+		key := execution.GroupKey{record.Values[l.keyFieldIndex]}
+		keyItem, ok := keys.Get(&latestKeyItem{key: key})
+		if ok {
+			if record.Values[l.timeFieldIndex].Time.Before(keyItem.values[l.timeFieldIndex].Time) {
+				return nil
+			}
+			// The retraction is stamped with the time of the record replacing it,
+			// so that it isn't dropped as late once the watermark passes the time of the previous one.
+			if err := produce(produceCtx, execution.NewRecord(keyItem.values, true, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce retraction of previous record: %w", err)
+			}
+		} else {
+			keyItem = &latestKeyItem{key: key}
+			keys.Set(keyItem)
+		}
+		keyItem.values = record.Values
+
+		if err := produce(produceCtx, record); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+		return nil
+	}, metaSend); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return nil
+}
@@ -118,6 +118,7 @@ var MaxDiffWatermark = logical.TableValuedFunctionDescription{
 					maxDifference:  maxDifference,
 					resolution:     resolution,
 					timeFieldIndex: timeFieldIndex,
+					lateRecords:    env.LateRecords,
 				}, nil
 			},
 		},
@@ -129,6 +130,8 @@ type maxDifferenceWatermarkGenerator struct {
 	maxDifference  execution.Expression
 	resolution     execution.Expression
 	timeFieldIndex int
+	// Records behind the watermark are passed on only if they're within the allowed lateness.
+	lateRecords *execution.LateRecords
 }
 
 func (m *maxDifferenceWatermarkGenerator) Run(ctx execution.ExecutionContext, produce execution.ProduceFn, metaSend execution.MetaSendFn) error {
@@ -145,11 +148,13 @@ func (m *maxDifferenceWatermarkGenerator) Run(ctx execution.ExecutionContext, pr
 	}
 
 	if err := m.source.Run(ctx, func(ctx execution.ProduceContext, record execution.Record) error {
-		if record.Values[m.timeFieldIndex].Time.After(curWatermark) {
-			record.EventTime = record.Values[m.timeFieldIndex].Time
-			if err := produce(ctx, record); err != nil {
-				return fmt.Errorf("couldn't produce record: %w", err)
+		record.EventTime = record.Values[m.timeFieldIndex].Time
+		if m.lateRecords.TooLate(record.EventTime, curWatermark) {
+			if err := m.lateRecords.Drop(record); err != nil {
+				return fmt.Errorf("couldn't drop late record: %w", err)
 			}
+		} else if err := produce(ctx, record); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
 		}
 
 		curTimeValueRoundedDown := time.Unix(0, record.Values[m.timeFieldIndex].Time.UnixNano()/int64(resolution.Duration)*int64(resolution.Duration))
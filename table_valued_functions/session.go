@@ -0,0 +1,337 @@
+package table_valued_functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/logical"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var Session = logical.TableValuedFunctionDescription{
+	TypecheckArguments: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionArgumentValue) map[string]logical.TableValuedFunctionTypecheckedArgument {
+		outArgs := make(map[string]logical.TableValuedFunctionTypecheckedArgument)
+
+		source, mapping := args["source"].(*logical.TableValuedFunctionArgumentValueTable).
+			Typecheck(ctx, env, logicalEnv)
+		outArgs["source"] = logical.TableValuedFunctionTypecheckedArgument{Mapping: mapping, Argument: source}
+
+		outArgs["gap"] = logical.TableValuedFunctionTypecheckedArgument{
+			Argument: args["gap"].(*logical.TableValuedFunctionArgumentValueExpression).
+				Typecheck(ctx, env, logicalEnv),
+		}
+		if _, ok := args["time_field"]; ok {
+			outArgs["time_field"] = logical.TableValuedFunctionTypecheckedArgument{
+				Argument: args["time_field"].(*logical.TableValuedFunctionArgumentValueDescriptor).
+					Typecheck(ctx, env, logicalEnv.WithRecordUniqueVariableNames(mapping)),
+			}
+		}
+		if _, ok := args["key"]; ok {
+			outArgs["key"] = logical.TableValuedFunctionTypecheckedArgument{
+				Argument: args["key"].(*logical.TableValuedFunctionArgumentValueDescriptor).
+					Typecheck(ctx, env, logicalEnv.WithRecordUniqueVariableNames(mapping)),
+			}
+		}
+
+		return outArgs
+	},
+	Descriptors: []logical.TableValuedFunctionDescriptor{
+		{
+			Arguments: map[string]logical.TableValuedFunctionArgumentMatcher{
+				"source": {
+					Required:                               true,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeTable,
+					Table:                                  &logical.TableValuedFunctionArgumentMatcherTable{},
+				},
+				"gap": {
+					Required:                               true,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeExpression,
+					Expression: &logical.TableValuedFunctionArgumentMatcherExpression{
+						Type: octosql.Duration,
+					},
+				},
+				"time_field": {
+					Required:                               false,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeDescriptor,
+					Descriptor:                             &logical.TableValuedFunctionArgumentMatcherDescriptor{},
+				},
+				"key": {
+					Required:                               false,
+					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeDescriptor,
+					Descriptor:                             &logical.TableValuedFunctionArgumentMatcherDescriptor{},
+				},
+			},
+			OutputSchema: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionTypecheckedArgument) (physical.Schema, map[string]string, error) {
+				source := args["source"].Argument.Table.Table
+				if timeFieldDescriptor, ok := args["time_field"]; ok {
+					timeField := timeFieldDescriptor.Argument.Descriptor.Descriptor
+					found := false
+					for _, field := range source.Schema.Fields {
+						if field.Name != timeField {
+							continue
+						}
+						if field.Type.TypeID != octosql.TypeIDTime {
+							return physical.Schema{}, nil, fmt.Errorf("time_field must reference Time typed field, is %s", field.Type.String())
+						}
+						found = true
+						break
+					}
+					if !found {
+						return physical.Schema{}, nil, fmt.Errorf("no %s field in source stream", timeField)
+					}
+				} else {
+					if source.Schema.TimeField == -1 {
+						return physical.Schema{}, nil, fmt.Errorf("the source table has no implicit watermarked time field, time_field must be specified explicitly")
+					}
+				}
+				outMapping := make(map[string]string)
+				for k, v := range args["source"].Mapping {
+					outMapping[k] = v
+				}
+				outFields := make([]physical.SchemaField, len(source.Schema.Fields)+2)
+				copy(outFields, source.Schema.Fields)
+
+				uniqueWindowStart := logicalEnv.GetUnique("window_start")
+				outMapping["window_start"] = uniqueWindowStart
+				outFields[len(source.Schema.Fields)] = physical.SchemaField{
+					Name: uniqueWindowStart,
+					Type: octosql.Time,
+				}
+
+				uniqueWindowEnd := logicalEnv.GetUnique("window_end")
+				outMapping["window_end"] = uniqueWindowEnd
+				outFields[len(source.Schema.Fields)+1] = physical.SchemaField{
+					Name: uniqueWindowEnd,
+					Type: octosql.Time,
+				}
+				return physical.Schema{
+					Fields:    outFields,
+					TimeField: len(source.Schema.Fields) + 1,
+					// Records get retracted when their sessions get merged.
+					NoRetractions: false,
+				}, outMapping, nil
+			},
+			Materialize: func(ctx context.Context, env physical.Environment, args map[string]physical.TableValuedFunctionArgument) (execution.Node, error) {
+				source, err := args["source"].Table.Table.Materialize(ctx, env)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize source table: %w", err)
+				}
+				gap, err := args["gap"].Expression.Expression.Materialize(ctx, env)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize gap: %w", err)
+				}
+				var timeFieldIndex int
+				if timeFieldDescriptor, ok := args["time_field"]; ok {
+					timeField := timeFieldDescriptor.Descriptor.Descriptor
+					for i, field := range args["source"].Table.Table.Schema.Fields {
+						if field.Name == timeField {
+							timeFieldIndex = i
+							break
+						}
+					}
+				} else {
+					timeFieldIndex = args["source"].Table.Table.Schema.TimeField
+				}
+				keyFieldIndex := -1
+				if keyDescriptor, ok := args["key"]; ok {
+					keyField := keyDescriptor.Descriptor.Descriptor
+					for i, field := range args["source"].Table.Table.Schema.Fields {
+						if field.Name == keyField {
+							keyFieldIndex = i
+							break
+						}
+					}
+				}
+
+				return &session{
+					source:         source,
+					timeFieldIndex: timeFieldIndex,
+					keyFieldIndex:  keyFieldIndex,
+					gap:            gap,
+					lateRecords:    env.LateRecords,
+				}, nil
+			},
+		},
+	},
+}
+
+// session assigns records to per-key sessions of activity.
+// A session contains all records which are less than the gap apart from the next one,
+// it starts at the first record's time and ends a gap after the last record's time.
+// When a record causes sessions to grow or merge, the records of the old sessions get retracted and sent again with the new window.
+// Sessions are finished, and their state is freed, when the watermark reaches their end plus the allowed lateness,
+// so that late records which are still allowed can merge with them.
+type session struct {
+	source         execution.Node
+	timeFieldIndex int
+	// Index of the key field, or -1 if all records are part of the same sessions.
+	keyFieldIndex int
+	gap           execution.Expression
+	lateRecords   *execution.LateRecords
+}
+
+type sessionWindow struct {
+	key        execution.GroupKey
+	start, end time.Time
+	records    [][]octosql.Value
+}
+
+type sessionKeyItem struct {
+	key execution.GroupKey
+	// Non-overlapping sessions, ordered by start.
+	sessions []*sessionWindow
+}
+
+func (s *session) Run(ctx execution.ExecutionContext, produce execution.ProduceFn, metaSend execution.MetaSendFn) error {
+	gapValue, err := s.gap.Evaluate(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't evaluate gap: %w", err)
+	}
+	gap := gapValue.Duration
+	if gap <= 0 {
+		return fmt.Errorf("session gap must be positive, is %s", gap)
+	}
+
+	keys := btree.NewGenericOptions(func(a, b *sessionKeyItem) bool {
+		return execution.CompareValueSlices(a.key, b.key)
+	}, btree.Options{
+		NoLocks: true,
+	})
+	// All open sessions, ordered by end, so that finished ones can be found when the watermark rises.
+	sessionsByEnd := btree.NewGenericOptions(func(a, b *sessionWindow) bool {
+		if !a.end.Equal(b.end) {
+			return a.end.Before(b.end)
+		}
+		if execution.CompareValueSlices(a.key, b.key) {
+			return true
+		} else if execution.CompareValueSlices(b.key, a.key) {
+			return false
+		}
+		return a.start.Before(b.start)
+	}, btree.Options{
+		NoLocks: true,
+	})
+
+	produceWithWindow := func(produceCtx execution.ProduceContext, values []octosql.Value, window *sessionWindow, retraction bool) error {
+		outValues := make([]octosql.Value, len(values)+2)
+		copy(outValues, values)
+		outValues[len(values)] = octosql.NewTime(window.start)
+		outValues[len(values)+1] = octosql.NewTime(window.end)
+
+		if err := produce(produceCtx, execution.NewRecord(outValues, retraction, window.end)); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.source.Run(ctx, func(produceCtx execution.ProduceContext, record execution.Record) error {
+		if record.Retraction {
+			return fmt.Errorf("session windows don't support retractions")
+		}
+
+		key := execution.GroupKey{}
+		if s.keyFieldIndex != -1 {
+			key = execution.GroupKey{record.Values[s.keyFieldIndex]}
+		}
+		keyItem, ok := keys.Get(&sessionKeyItem{key: key})
+		if !ok {
+			keyItem = &sessionKeyItem{key: key}
+			keys.Set(keyItem)
+		}
+
+		recordTime := record.Values[s.timeFieldIndex].Time
+		newWindow := &sessionWindow{
+			key:   key,
+			start: recordTime,
+			end:   recordTime.Add(gap),
+		}
+
+		// Merge all the sessions overlapping with the record's one.
+		var merged []*sessionWindow
+		remaining := make([]*sessionWindow, 0, len(keyItem.sessions)+1)
+		for _, window := range keyItem.sessions {
+			if window.start.Before(recordTime.Add(gap)) && recordTime.Before(window.end) {
+				merged = append(merged, window)
+				if window.start.Before(newWindow.start) {
+					newWindow.start = window.start
+				}
+				if window.end.After(newWindow.end) {
+					newWindow.end = window.end
+				}
+				continue
+			}
+			remaining = append(remaining, window)
+		}
+
+		for _, window := range merged {
+			sessionsByEnd.Delete(window)
+			if window.start.Equal(newWindow.start) && window.end.Equal(newWindow.end) {
+				newWindow.records = append(newWindow.records, window.records...)
+				continue
+			}
+			for _, values := range window.records {
+				if err := produceWithWindow(produceCtx, values, window, true); err != nil {
+					return err
+				}
+			}
+			for _, values := range window.records {
+				if err := produceWithWindow(produceCtx, values, newWindow, false); err != nil {
+					return err
+				}
+			}
+			newWindow.records = append(newWindow.records, window.records...)
+		}
+		newWindow.records = append(newWindow.records, record.Values)
+		if err := produceWithWindow(produceCtx, record.Values, newWindow, false); err != nil {
+			return err
+		}
+
+		insertIndex := len(remaining)
+		for i, window := range remaining {
+			if newWindow.start.Before(window.start) {
+				insertIndex = i
+				break
+			}
+		}
+		remaining = append(remaining, nil)
+		copy(remaining[insertIndex+1:], remaining[insertIndex:])
+		remaining[insertIndex] = newWindow
+		keyItem.sessions = remaining
+		sessionsByEnd.Set(newWindow)
+
+		return nil
+	}, func(produceCtx execution.ProduceContext, msg execution.MetadataMessage) error {
+		if msg.Type == execution.MetadataMessageTypeWatermark {
+			// No future record can join a session which ends before the watermark, minus the allowed lateness.
+			expiredBefore := msg.Watermark.Add(-s.lateRecords.AllowedLateness())
+			for {
+				window, ok := sessionsByEnd.Min()
+				if !ok || window.end.After(expiredBefore) {
+					break
+				}
+				sessionsByEnd.Delete(window)
+
+				keyItem, _ := keys.Get(&sessionKeyItem{key: window.key})
+				for i := range keyItem.sessions {
+					if keyItem.sessions[i] == window {
+						keyItem.sessions = append(keyItem.sessions[:i], keyItem.sessions[i+1:]...)
+						break
+					}
+				}
+				if len(keyItem.sessions) == 0 {
+					keys.Delete(keyItem)
+				}
+			}
+		}
+		return metaSend(produceCtx, msg)
+	}); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return nil
+}